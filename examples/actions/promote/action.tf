@@ -0,0 +1,25 @@
+# Promote every new application version to QA once it has been created.
+resource "apptrust_application_version" "example" {
+  application_key  = "my-web-app"
+  version          = "1.0.0"
+  source_artifacts = [{ path = "generic-repo/path/to/artifact.jar" }]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.apptrust_promote.qa]
+    }
+  }
+}
+
+action "apptrust_promote" "qa" {
+  config {
+    application_key = "my-web-app"
+    version         = "1.0.0"
+    target_stage    = "QA"
+    promotion_type  = "copy"
+  }
+}
+
+# The action can also be invoked on demand:
+#   terraform apply -invoke=action.apptrust_promote.qa
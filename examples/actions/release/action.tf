@@ -0,0 +1,9 @@
+# Release the application version on demand:
+#   terraform apply -invoke=action.apptrust_release.prod
+action "apptrust_release" "prod" {
+  config {
+    application_key = "my-web-app"
+    version         = "1.0.0"
+    promotion_type  = "copy"
+  }
+}
@@ -0,0 +1,9 @@
+# Roll the application version back from PROD on demand:
+#   terraform apply -invoke=action.apptrust_rollback.prod
+action "apptrust_rollback" "prod" {
+  config {
+    application_key = "my-web-app"
+    version         = "1.0.0"
+    from_stage      = "PROD"
+  }
+}
@@ -0,0 +1,13 @@
+# Requires Terraform 1.10 or later. The token is never written to the plan or state.
+ephemeral "apptrust_application_access_token" "ci" {
+  application_key = "my-web-app"
+  role            = "Developer"
+  expires_in      = 1800
+}
+
+# Ephemeral values can be used in provider configurations, e.g. to manage the project's
+# repositories with the permissions of the application's project only.
+provider "artifactory" {
+  url          = "https://myinstance.jfrog.io"
+  access_token = ephemeral.apptrust_application_access_token.ci.access_token
+}
@@ -0,0 +1,4 @@
+import {
+  to = apptrust_bound_package.left_pad
+  id = provider::apptrust::bound_package_id("my-web-app", "npm", "left-pad", "1.3.0")
+}
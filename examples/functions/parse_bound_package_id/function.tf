@@ -0,0 +1,7 @@
+locals {
+  bound = provider::apptrust::parse_bound_package_id("my-web-app:maven:org.example:lib:1.2.0")
+}
+
+output "package_name" {
+  value = local.bound.package_name # "org.example:lib"
+}
@@ -0,0 +1,4 @@
+output "promoted_to" {
+  # "QA" for the ID "my-web-app:1.0.0:QA"
+  value = provider::apptrust::parse_promotion_id(apptrust_application_version_promotion.example.id).stage
+}
@@ -0,0 +1,12 @@
+# Read the application and version of an imported application version.
+locals {
+  version_id = provider::apptrust::parse_version_id(apptrust_application_version.example.id)
+}
+
+output "application_key" {
+  value = local.version_id.application_key # "my-web-app"
+}
+
+output "version" {
+  value = local.version_id.version # "1.0.0"
+}
@@ -0,0 +1,12 @@
+variable "release_version" {
+  type = string
+}
+
+# Only promote to PROD from 2.0.0 on.
+resource "apptrust_application_version_promotion" "prod" {
+  count = provider::apptrust::semver_compare(var.release_version, "2.0.0") >= 0 ? 1 : 0
+
+  application_key = "my-web-app"
+  version         = var.release_version
+  target_stage    = "PROD"
+}
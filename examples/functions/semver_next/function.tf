@@ -0,0 +1,11 @@
+# Versions are listed newest first.
+data "apptrust_application_versions" "example" {
+  application_key = "my-web-app"
+  limit           = 1
+}
+
+resource "apptrust_application_version" "next" {
+  application_key  = "my-web-app"
+  version          = provider::apptrust::semver_next(data.apptrust_application_versions.example.versions[0].version, "minor")
+  source_artifacts = [{ path = "generic-repo/app.tgz" }]
+}
@@ -0,0 +1,11 @@
+# Generate configuration and import blocks for the applications of a project:
+#   terraform query -generate-config-out=applications.tf
+list "apptrust_application" "production" {
+  provider         = apptrust
+  include_resource = true
+
+  config {
+    project_key = "my-project"
+    maturity    = "production"
+  }
+}
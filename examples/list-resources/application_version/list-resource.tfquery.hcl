@@ -0,0 +1,11 @@
+# Generate configuration and import blocks for the released versions of an application:
+#   terraform query -generate-config-out=versions.tf
+list "apptrust_application_version" "released" {
+  provider         = apptrust
+  include_resource = true
+
+  config {
+    application_key = "my-web-app"
+    release_status  = "released"
+  }
+}
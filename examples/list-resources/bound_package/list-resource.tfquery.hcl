@@ -0,0 +1,11 @@
+# Generate configuration and import blocks for the npm packages bound to an application:
+#   terraform query -generate-config-out=bound_packages.tf
+list "apptrust_bound_package" "npm" {
+  provider         = apptrust
+  include_resource = true
+
+  config {
+    application_key = "my-web-app"
+    type            = "npm"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = apptrust_application.example
+  identity = {
+    application_key = "my-web-app"
+  }
+}
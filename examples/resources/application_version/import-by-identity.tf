@@ -0,0 +1,7 @@
+import {
+  to = apptrust_application_version.example
+  identity = {
+    application_key = "my-web-app"
+    version         = "1.0.0"
+  }
+}
@@ -0,0 +1,6 @@
+# Moves a Release Bundle v2 of the Artifactory provider, named after the application key, to an
+# application version without recreating it. Requires Terraform 1.8 or later.
+moved {
+  from = artifactory_release_bundle_v2.my-web-app
+  to   = apptrust_application_version.example
+}
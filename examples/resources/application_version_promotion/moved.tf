@@ -0,0 +1,6 @@
+# Moves a Release Bundle v2 promotion of the Artifactory provider to an application version
+# promotion without promoting again. Requires Terraform 1.8 or later.
+moved {
+  from = artifactory_release_bundle_v2_promotion.my-web-app-qa
+  to   = apptrust_application_version_promotion.example
+}
@@ -0,0 +1,8 @@
+import {
+  to = apptrust_application_version_rollback.example
+  identity = {
+    application_key = "my-web-app"
+    version         = "1.0.0"
+    from_stage      = "QA"
+  }
+}
@@ -0,0 +1,9 @@
+import {
+  to = apptrust_bound_package.example
+  identity = {
+    application_key = "my-web-app"
+    package_type    = "maven"
+    package_name    = "com.example:my-library"
+    package_version = "1.2.3"
+  }
+}
@@ -39,6 +39,9 @@ func main() {
 	}
 
 	err := providerserver.Serve(context.Background(), provider.Framework(), opts)
+	// Usage telemetry is batched for the whole run and sent once the server has stopped, before
+	// go-plugin kills the process. The flush gives up after 1 second (see disable_telemetry).
+	provider.FlushUsage(context.Background())
 	if err != nil {
 		log.Fatal(err.Error())
 	}
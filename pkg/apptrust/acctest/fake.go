@@ -0,0 +1,68 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acctest
+
+import (
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest/fakeserver"
+)
+
+// FakeServerEnvVar enables running the acceptance tests against an in-process fake of the
+// AppTrust API instead of a live platform.
+const FakeServerEnvVar = "APPTRUST_FAKE_SERVER"
+
+var (
+	fakeServer     *fakeserver.Server
+	fakeServerOnce sync.Once
+)
+
+// UseFakeServer reports whether APPTRUST_FAKE_SERVER is set to a true value.
+func UseFakeServer() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(FakeServerEnvVar))
+	return enabled
+}
+
+// FakeServer returns the in-process fake shared by all tests of the package, starting it on
+// first use. Starting it points JFROG_URL and JFROG_ACCESS_TOKEN at the fake and sets TF_ACC, so
+// ProtoV6ProviderFactories, the CheckDestroy helpers and resource.Test all use it. The optional
+// APPTRUST_TEST_* settings are defaulted so the tests needing specific platform data run too.
+// The server lives until the test binary exits.
+func FakeServer() *fakeserver.Server {
+	fakeServerOnce.Do(func() {
+		fakeServer = fakeserver.New(AppTrustProjectKey1, AppTrustProjectKey2, AppTrustProjectKey3, AppTrustProjectKey4)
+
+		for key, value := range map[string]string{
+			"JFROG_URL":          fakeServer.URL,
+			"JFROG_ACCESS_TOKEN": fakeserver.AccessToken,
+			"TF_ACC":             "true",
+		} {
+			os.Setenv(key, value)
+		}
+		for key, value := range map[string]string{
+			"APPTRUST_TEST_PACKAGE_TYPE":    "generic",
+			"APPTRUST_TEST_PACKAGE_NAME":    "fake-package",
+			"APPTRUST_TEST_PACKAGE_VERSION": "1.0.0",
+			"APPTRUST_TEST_RELEASE":         "true",
+		} {
+			if os.Getenv(key) == "" {
+				os.Setenv(key, value)
+			}
+		}
+	})
+	return fakeServer
+}
@@ -0,0 +1,989 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeserver is an in-process, in-memory fake of the JFrog AppTrust REST API for
+// offline tests. It implements the applications, versions, promote/release/rollback, status,
+// promotions and packages endpoints, the Access API token endpoints, plus the Artifactory/Xray
+// version and usage endpoints the provider calls while configuring, and answers with the status codes and `errors` bodies of
+// the real API.
+//
+// The fake keeps its own wire models on purpose: it must not share types with the client
+// package it is used to test.
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// AccessToken is the bearer token accepted by the fake.
+	AccessToken = "fake-apptrust-access-token"
+	// User is reported as the creator of versions and promotions.
+	User = "fake-admin"
+
+	// ArtifactoryVersion and XrayVersion are reported by the system version endpoints. The fake
+	// reports the provider minimum Artifactory version, so tests run against the oldest platform
+	// the provider supports.
+	ArtifactoryVersion = "7.125.0"
+	XrayVersion        = "3.130.5"
+
+	defaultPageSize = 25
+)
+
+var (
+	maturityLevels    = []string{"unspecified", "experimental", "production", "end_of_life"}
+	criticalityLevels = []string{"unspecified", "low", "medium", "high", "critical"}
+	promotionTypes    = []string{"move", "copy", "keep", "dry_run"}
+)
+
+// Server is a running fake. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	projects     map[string]bool
+	applications map[string]*application
+	// order keeps application keys in creation order, which is the default list order.
+	order []string
+	// tokens holds the access tokens that were created and not revoked, by token ID.
+	tokens      map[string]*token
+	tokenSerial int
+}
+
+// New starts a fake that accepts the given project keys.
+func New(projectKeys ...string) *Server {
+	s := &Server{
+		projects:     map[string]bool{},
+		applications: map[string]*application{},
+		tokens:       map[string]*token{},
+	}
+	for _, key := range projectKeys {
+		s.projects[key] = true
+	}
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+type application struct {
+	ApplicationKey  string            `json:"application_key"`
+	ApplicationName string            `json:"application_name"`
+	ProjectKey      string            `json:"project_key"`
+	Description     string            `json:"description"`
+	MaturityLevel   string            `json:"maturity_level"`
+	Criticality     string            `json:"criticality"`
+	Labels          map[string]string `json:"labels"`
+	UserOwners      []string          `json:"user_owners"`
+	GroupOwners     []string          `json:"group_owners"`
+	Created         string            `json:"created"`
+	CreatedBy       string            `json:"created_by"`
+	Modified        string            `json:"modified"`
+	ModifiedBy      string            `json:"modified_by"`
+	VersionsCount   int               `json:"versions_count"`
+	LatestVersion   string            `json:"latest_version,omitempty"`
+
+	versions []*version
+	packages []*boundPackage
+}
+
+type version struct {
+	Version       string `json:"version"`
+	Tag           string `json:"tag"`
+	Status        string `json:"status"`
+	ReleaseStatus string `json:"release_status"`
+	CurrentStage  string `json:"current_stage"`
+	CreatedBy     string `json:"created_by"`
+	Created       string `json:"created"`
+
+	properties map[string][]string
+	promotions []*promotion
+}
+
+type promotion struct {
+	ApplicationKey     string             `json:"application_key"`
+	ApplicationVersion string             `json:"application_version"`
+	ProjectKey         string             `json:"project_key"`
+	SourceStage        string             `json:"source_stage"`
+	TargetStage        string             `json:"target_stage"`
+	Status             string             `json:"status"`
+	Created            string             `json:"created"`
+	CreatedBy          string             `json:"created_by"`
+	CreatedMillis      int64              `json:"created_millis"`
+	Messages           []promotionMessage `json:"messages"`
+}
+
+type promotionMessage struct {
+	Text string `json:"text"`
+}
+
+type boundPackage struct {
+	Type    string
+	Name    string
+	Version string
+}
+
+type token struct {
+	TokenID     string `json:"token_id"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+}
+
+type apiError struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /artifactory/api/system/version", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"version": ArtifactoryVersion, "revision": "0", "addons": []string{}})
+	})
+	mux.HandleFunc("GET /xray/api/v1/system/version", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"xray_version": XrayVersion, "xray_revision": "0"})
+	})
+	mux.HandleFunc("POST /artifactory/api/system/usage", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	const base = "/apptrust/api/v1/applications"
+	mux.HandleFunc("GET "+base, s.listApplications)
+	mux.HandleFunc("POST "+base, s.createApplication)
+	mux.HandleFunc("GET "+base+"/{application_key}", s.getApplication)
+	mux.HandleFunc("PATCH "+base+"/{application_key}", s.updateApplication)
+	mux.HandleFunc("DELETE "+base+"/{application_key}", s.deleteApplication)
+
+	mux.HandleFunc("GET "+base+"/{application_key}/versions", s.listVersions)
+	mux.HandleFunc("POST "+base+"/{application_key}/versions", s.createVersion)
+	mux.HandleFunc("PATCH "+base+"/{application_key}/versions/{version}", s.updateVersion)
+	mux.HandleFunc("DELETE "+base+"/{application_key}/versions/{version}", s.deleteVersion)
+	mux.HandleFunc("GET "+base+"/{application_key}/versions/{version}/status", s.versionStatus)
+	mux.HandleFunc("POST "+base+"/{application_key}/versions/{version}/promote", s.promoteVersion)
+	mux.HandleFunc("POST "+base+"/{application_key}/versions/{version}/release", s.releaseVersion)
+	mux.HandleFunc("POST "+base+"/{application_key}/versions/{version}/rollback", s.rollbackVersion)
+	mux.HandleFunc("GET "+base+"/{application_key}/versions/{version}/promotions", s.listPromotions)
+
+	mux.HandleFunc("GET "+base+"/{application_key}/packages", s.listPackages)
+	mux.HandleFunc("POST "+base+"/{application_key}/packages", s.bindPackage)
+	mux.HandleFunc("GET "+base+"/{application_key}/packages/{type}/{name}", s.listPackageVersions)
+	mux.HandleFunc("DELETE "+base+"/{application_key}/packages/{type}/{name}/{version}", s.unbindPackage)
+
+	mux.HandleFunc("POST /access/api/v1/tokens", s.createToken)
+	mux.HandleFunc("DELETE /access/api/v1/tokens/{token_id}", s.revokeToken)
+
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without the fake bearer token, like the platform does for
+// missing or expired tokens.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+AccessToken {
+			writeErrors(w, http.StatusUnauthorized, apiError{Code: "UNAUTHORIZED", Message: "Bad credentials"})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...apiError) {
+	writeJSON(w, status, map[string][]apiError{"errors": errs})
+}
+
+func writeNotFound(w http.ResponseWriter, format string, args ...interface{}) {
+	writeErrors(w, http.StatusNotFound, apiError{Code: "NOT_FOUND", Message: fmt.Sprintf(format, args...)})
+}
+
+func writeBadRequest(w http.ResponseWriter, field, format string, args ...interface{}) {
+	writeErrors(w, http.StatusBadRequest, apiError{Code: "BAD_REQUEST", Message: fmt.Sprintf(format, args...), Field: field})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeBadRequest(w, "", "Malformed request body: %s", err)
+		return false
+	}
+	return true
+}
+
+// page applies the offset and limit query parameters to a list of n items and returns the
+// bounds of the page.
+func page(r *http.Request, n int) (offset, limit, start, end int) {
+	offset, _ = strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultPageSize
+	}
+	start = min(max(offset, 0), n)
+	end = min(start+limit, n)
+	return offset, limit, start, end
+}
+
+// lookup returns the application of the request path, writing a 404 when it does not exist.
+// The caller must hold s.mu.
+func (s *Server) lookup(w http.ResponseWriter, r *http.Request) *application {
+	key := r.PathValue("application_key")
+	app, ok := s.applications[key]
+	if !ok {
+		writeNotFound(w, "Application '%s' not found", key)
+		return nil
+	}
+	return app
+}
+
+// lookupVersion returns the application and version of the request path, writing a 404 when
+// either does not exist. The caller must hold s.mu.
+func (s *Server) lookupVersion(w http.ResponseWriter, r *http.Request) (*application, *version) {
+	app := s.lookup(w, r)
+	if app == nil {
+		return nil, nil
+	}
+	name := r.PathValue("version")
+	for _, v := range app.versions {
+		if v.Version == name {
+			return app, v
+		}
+	}
+	writeNotFound(w, "Version '%s' of application '%s' not found", name, app.ApplicationKey)
+	return nil, nil
+}
+
+func (s *Server) listApplications(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	var result []*application
+	for _, key := range s.order {
+		app := s.applications[key]
+		if projectKey := query.Get("project_key"); projectKey != "" && app.ProjectKey != projectKey {
+			continue
+		}
+		if name := query.Get("name"); name != "" && !strings.Contains(app.ApplicationName, name) {
+			continue
+		}
+		if maturity := query.Get("maturity"); maturity != "" && app.MaturityLevel != maturity {
+			continue
+		}
+		if criticality := query.Get("criticality"); criticality != "" && app.Criticality != criticality {
+			continue
+		}
+		if !app.hasOwners(query["owner"]) || !app.hasLabels(query["label"]) {
+			continue
+		}
+		result = append(result, app)
+	}
+
+	if orderBy := query.Get("order_by"); orderBy == "name" {
+		slices.SortStableFunc(result, func(a, b *application) int {
+			return strings.Compare(a.ApplicationName, b.ApplicationName)
+		})
+	}
+	if query.Get("order_asc") == "false" {
+		slices.Reverse(result)
+	}
+
+	_, _, start, end := page(r, len(result))
+	writeJSON(w, http.StatusOK, result[start:end])
+}
+
+func (a *application) hasOwners(owners []string) bool {
+	for _, owner := range owners {
+		if !slices.Contains(a.UserOwners, owner) && !slices.Contains(a.GroupOwners, owner) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasLabels matches "key:value" label filters.
+func (a *application) hasLabels(labels []string) bool {
+	for _, label := range labels {
+		key, value, _ := strings.Cut(label, ":")
+		if actual, ok := a.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks the enum fields and fills in the defaults the API applies.
+func (a *application) validate() *apiError {
+	if a.MaturityLevel == "" {
+		a.MaturityLevel = "unspecified"
+	}
+	if a.Criticality == "" {
+		a.Criticality = "unspecified"
+	}
+	if !slices.Contains(maturityLevels, a.MaturityLevel) {
+		return &apiError{Code: "BAD_REQUEST", Field: "maturity_level", Message: fmt.Sprintf("must be one of %s", strings.Join(maturityLevels, ", "))}
+	}
+	if !slices.Contains(criticalityLevels, a.Criticality) {
+		return &apiError{Code: "BAD_REQUEST", Field: "criticality", Message: fmt.Sprintf("must be one of %s", strings.Join(criticalityLevels, ", "))}
+	}
+	if a.Labels == nil {
+		a.Labels = map[string]string{}
+	}
+	if a.UserOwners == nil {
+		a.UserOwners = []string{}
+	}
+	if a.GroupOwners == nil {
+		a.GroupOwners = []string{}
+	}
+	return nil
+}
+
+// updateVersionStats sets versions_count and latest_version after a version is created or deleted.
+func (a *application) updateVersionStats() {
+	a.VersionsCount = len(a.versions)
+	a.LatestVersion = ""
+	if len(a.versions) > 0 {
+		a.LatestVersion = a.versions[len(a.versions)-1].Version
+	}
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request) {
+	var app application
+	if !decode(w, r, &app) {
+		return
+	}
+
+	var errs []apiError
+	if app.ApplicationKey == "" {
+		errs = append(errs, apiError{Code: "BAD_REQUEST", Field: "application_key", Message: "must not be blank"})
+	}
+	if app.ApplicationName == "" {
+		errs = append(errs, apiError{Code: "BAD_REQUEST", Field: "application_name", Message: "must not be blank"})
+	}
+	if app.ProjectKey == "" {
+		errs = append(errs, apiError{Code: "BAD_REQUEST", Field: "project_key", Message: "must not be blank"})
+	}
+	if err := app.validate(); err != nil {
+		errs = append(errs, *err)
+	}
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.projects[app.ProjectKey] {
+		writeNotFound(w, "Project '%s' not found", app.ProjectKey)
+		return
+	}
+	if _, ok := s.applications[app.ApplicationKey]; ok {
+		writeErrors(w, http.StatusConflict, apiError{Code: "CONFLICT", Message: fmt.Sprintf("Application with key '%s' already exists", app.ApplicationKey)})
+		return
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	app.Created, app.CreatedBy, app.Modified, app.ModifiedBy = now, User, now, User
+	app.VersionsCount, app.LatestVersion = 0, ""
+	s.applications[app.ApplicationKey] = &app
+	s.order = append(s.order, app.ApplicationKey)
+	writeJSON(w, http.StatusCreated, &app)
+}
+
+func (s *Server) getApplication(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if app := s.lookup(w, r); app != nil {
+		writeJSON(w, http.StatusOK, app)
+	}
+}
+
+func (s *Server) updateApplication(w http.ResponseWriter, r *http.Request) {
+	var update struct {
+		ApplicationName *string            `json:"application_name"`
+		Description     *string            `json:"description"`
+		MaturityLevel   *string            `json:"maturity_level"`
+		Criticality     *string            `json:"criticality"`
+		Labels          *map[string]string `json:"labels"`
+		UserOwners      *[]string          `json:"user_owners"`
+		GroupOwners     *[]string          `json:"group_owners"`
+	}
+	if !decode(w, r, &update) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	updated := *app
+	if update.ApplicationName != nil {
+		if *update.ApplicationName == "" {
+			writeBadRequest(w, "application_name", "must not be blank")
+			return
+		}
+		updated.ApplicationName = *update.ApplicationName
+	}
+	if update.Description != nil {
+		updated.Description = *update.Description
+	}
+	if update.MaturityLevel != nil {
+		updated.MaturityLevel = *update.MaturityLevel
+	}
+	if update.Criticality != nil {
+		updated.Criticality = *update.Criticality
+	}
+	if update.Labels != nil {
+		updated.Labels = *update.Labels
+	}
+	if update.UserOwners != nil {
+		updated.UserOwners = *update.UserOwners
+	}
+	if update.GroupOwners != nil {
+		updated.GroupOwners = *update.GroupOwners
+	}
+	if err := updated.validate(); err != nil {
+		writeErrors(w, http.StatusBadRequest, *err)
+		return
+	}
+	updated.Modified, updated.ModifiedBy = time.Now().UTC().Format(time.RFC3339), User
+	*app = updated
+	writeJSON(w, http.StatusOK, app)
+}
+
+func (s *Server) deleteApplication(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	if len(app.versions) > 0 {
+		writeErrors(w, http.StatusConflict, apiError{
+			Code:    "CONFLICT",
+			Message: fmt.Sprintf("Application '%s' has %d versions and cannot be deleted", app.ApplicationKey, len(app.versions)),
+		})
+		return
+	}
+	if len(app.packages) > 0 {
+		writeErrors(w, http.StatusConflict, apiError{
+			Code:    "CONFLICT",
+			Message: fmt.Sprintf("Application '%s' has %d bound packages and cannot be deleted", app.ApplicationKey, len(app.packages)),
+		})
+		return
+	}
+	delete(s.applications, app.ApplicationKey)
+	s.order = slices.DeleteFunc(s.order, func(key string) bool { return key == app.ApplicationKey })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listVersions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	query := r.URL.Query()
+	result := []*version{}
+	for _, v := range app.versions {
+		if createdBy := query.Get("created_by"); createdBy != "" && v.CreatedBy != createdBy {
+			continue
+		}
+		if releaseStatus := query.Get("release_status"); releaseStatus != "" && v.ReleaseStatus != releaseStatus {
+			continue
+		}
+		if tag := query.Get("tag"); tag != "" && v.Tag != tag {
+			continue
+		}
+		result = append(result, v)
+	}
+	// Newest first unless order_asc=true.
+	if query.Get("order_asc") != "true" {
+		slices.Reverse(result)
+	}
+
+	offset, limit, start, end := page(r, len(result))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"versions": result[start:end],
+		"total":    len(result),
+		"offset":   offset,
+		"limit":    limit,
+	})
+}
+
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Version string `json:"version"`
+		Tag     string `json:"tag"`
+		Sources struct {
+			Artifacts []json.RawMessage `json:"artifacts"`
+			Builds    []json.RawMessage `json:"builds"`
+			Versions  []struct {
+				ApplicationKey string `json:"application_key"`
+				Version        string `json:"version"`
+			} `json:"versions"`
+		} `json:"sources"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Version == "" {
+		writeBadRequest(w, "version", "must not be blank")
+		return
+	}
+	if len(body.Sources.Artifacts)+len(body.Sources.Builds)+len(body.Sources.Versions) == 0 {
+		writeBadRequest(w, "sources", "at least one source must be provided")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	for _, source := range body.Sources.Versions {
+		sourceApp, ok := s.applications[source.ApplicationKey]
+		if !ok || !slices.ContainsFunc(sourceApp.versions, func(v *version) bool { return v.Version == source.Version }) {
+			writeBadRequest(w, "sources.versions", "source version '%s' of application '%s' not found", source.Version, source.ApplicationKey)
+			return
+		}
+	}
+	for _, v := range app.versions {
+		if v.Version == body.Version {
+			writeErrors(w, http.StatusConflict, apiError{Code: "CONFLICT", Message: fmt.Sprintf("Version '%s' already exists", body.Version)})
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	v := &version{
+		Version:       body.Version,
+		Tag:           body.Tag,
+		Status:        "COMPLETED",
+		ReleaseStatus: "PRE_RELEASE",
+		CreatedBy:     User,
+		Created:       now.Format(time.RFC3339),
+	}
+	app.versions = append(app.versions, v)
+	app.updateVersionStats()
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"application_key": app.ApplicationKey,
+		"version":         v.Version,
+		"status":          v.Status,
+	})
+}
+
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Tag              *string             `json:"tag"`
+		Properties       map[string][]string `json:"properties"`
+		DeleteProperties []string            `json:"delete_properties"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if body.Tag != nil {
+		v.Tag = *body.Tag
+	}
+	if len(body.Properties) > 0 && v.properties == nil {
+		v.properties = map[string][]string{}
+	}
+	for key, values := range body.Properties {
+		v.properties[key] = values
+	}
+	for _, key := range body.DeleteProperties {
+		delete(v.properties, key)
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) deleteVersion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	app.versions = slices.DeleteFunc(app.versions, func(candidate *version) bool { return candidate == v })
+	app.updateVersionStats()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) versionStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, v := s.lookupVersion(w, r); v != nil {
+		writeJSON(w, http.StatusOK, map[string]string{"version_release_status": v.ReleaseStatus})
+	}
+}
+
+type promoteBody struct {
+	TargetStage   string `json:"target_stage"`
+	PromotionType string `json:"promotion_type"`
+	FromStage     string `json:"from_stage"`
+}
+
+// record moves v to targetStage and appends the promotion record. The caller must hold s.mu.
+func (v *version) record(app *application, targetStage, message string) {
+	now := time.Now().UTC()
+	// Records are ordered by creation time, so two records of a version never share one.
+	createdMillis := now.UnixMilli()
+	if n := len(v.promotions); n > 0 && v.promotions[n-1].CreatedMillis >= createdMillis {
+		createdMillis = v.promotions[n-1].CreatedMillis + 1
+	}
+	v.promotions = append(v.promotions, &promotion{
+		ApplicationKey:     app.ApplicationKey,
+		ApplicationVersion: v.Version,
+		ProjectKey:         app.ProjectKey,
+		SourceStage:        v.CurrentStage,
+		TargetStage:        targetStage,
+		Status:             "COMPLETED",
+		Created:            now.Format(time.RFC3339),
+		CreatedBy:          User,
+		CreatedMillis:      createdMillis,
+		Messages:           []promotionMessage{{Text: message}},
+	})
+	v.CurrentStage = targetStage
+}
+
+func (s *Server) promoteVersion(w http.ResponseWriter, r *http.Request) {
+	var body promoteBody
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.TargetStage == "" {
+		writeBadRequest(w, "target_stage", "must not be blank")
+		return
+	}
+	if body.PromotionType != "" && !slices.Contains(promotionTypes, body.PromotionType) {
+		writeBadRequest(w, "promotion_type", "must be one of %s", strings.Join(promotionTypes, ", "))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if body.PromotionType != "dry_run" {
+		v.record(app, body.TargetStage, fmt.Sprintf("Promoted to %s", body.TargetStage))
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "COMPLETED"})
+}
+
+func (s *Server) releaseVersion(w http.ResponseWriter, r *http.Request) {
+	var body promoteBody
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.PromotionType != "" && !slices.Contains(promotionTypes, body.PromotionType) {
+		writeBadRequest(w, "promotion_type", "must be one of %s", strings.Join(promotionTypes, ", "))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if body.PromotionType != "dry_run" {
+		v.record(app, "PROD", "Released")
+		v.ReleaseStatus = "RELEASED"
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "COMPLETED"})
+}
+
+// rollbackVersion returns the version to the stage it was promoted from. Rolling back from a
+// stage the version is not in is accepted as a no-op.
+func (s *Server) rollbackVersion(w http.ResponseWriter, r *http.Request) {
+	var body promoteBody
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.FromStage == "" {
+		writeBadRequest(w, "from_stage", "must not be blank")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	if v.CurrentStage == body.FromStage {
+		previous := ""
+		for _, p := range v.promotions {
+			if p.TargetStage == body.FromStage {
+				previous = p.SourceStage
+			}
+		}
+		v.record(app, previous, fmt.Sprintf("Rolled back from %s", body.FromStage))
+		if body.FromStage == "PROD" {
+			v.ReleaseStatus = "PRE_RELEASE"
+		}
+	}
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "COMPLETED"})
+}
+
+func (s *Server) listPromotions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, v := s.lookupVersion(w, r)
+	if v == nil {
+		return
+	}
+	query := r.URL.Query()
+	result := []*promotion{}
+	for _, p := range v.promotions {
+		if filterBy := query.Get("filter_by"); filterBy != "" && p.TargetStage != filterBy && p.SourceStage != filterBy {
+			continue
+		}
+		result = append(result, p)
+	}
+	if query.Get("order_asc") != "true" {
+		slices.Reverse(result)
+	}
+
+	offset, limit, start, end := page(r, len(result))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"promotions": result[start:end],
+		"total":      len(result),
+		"offset":     offset,
+		"limit":      limit,
+	})
+}
+
+func (s *Server) bindPackage(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		PackageType    string `json:"package_type"`
+		PackageName    string `json:"package_name"`
+		PackageVersion string `json:"package_version"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+	var errs []apiError
+	for field, value := range map[string]string{"package_type": body.PackageType, "package_name": body.PackageName, "package_version": body.PackageVersion} {
+		if value == "" {
+			errs = append(errs, apiError{Code: "BAD_REQUEST", Field: field, Message: "must not be blank"})
+		}
+	}
+	if len(errs) > 0 {
+		slices.SortFunc(errs, func(a, b apiError) int { return strings.Compare(a.Field, b.Field) })
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	binding := boundPackage{Type: body.PackageType, Name: body.PackageName, Version: body.PackageVersion}
+	if slices.ContainsFunc(app.packages, func(p *boundPackage) bool { return *p == binding }) {
+		writeErrors(w, http.StatusConflict, apiError{
+			Code:    "CONFLICT",
+			Message: fmt.Sprintf("Package %s:%s:%s is already bound to application '%s'", binding.Type, binding.Name, binding.Version, app.ApplicationKey),
+		})
+		return
+	}
+	app.packages = append(app.packages, &binding)
+	writeJSON(w, http.StatusCreated, body)
+}
+
+func (s *Server) unbindPackage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	binding := boundPackage{Type: r.PathValue("type"), Name: r.PathValue("name"), Version: r.PathValue("version")}
+	index := slices.IndexFunc(app.packages, func(p *boundPackage) bool { return *p == binding })
+	if index < 0 {
+		writeNotFound(w, "Package %s:%s:%s is not bound to application '%s'", binding.Type, binding.Name, binding.Version, app.ApplicationKey)
+		return
+	}
+	app.packages = slices.Delete(app.packages, index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) listPackages(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	type packageSummary struct {
+		Name          string `json:"name"`
+		Type          string `json:"type"`
+		NumVersions   int    `json:"num_versions"`
+		LatestVersion string `json:"latest_version"`
+	}
+	query := r.URL.Query()
+	result := []*packageSummary{}
+	for _, p := range app.packages {
+		if name := query.Get("name"); name != "" && !strings.Contains(p.Name, name) {
+			continue
+		}
+		if packageType := query.Get("type"); packageType != "" && p.Type != packageType {
+			continue
+		}
+		index := slices.IndexFunc(result, func(summary *packageSummary) bool {
+			return summary.Type == p.Type && summary.Name == p.Name
+		})
+		if index < 0 {
+			result = append(result, &packageSummary{Name: p.Name, Type: p.Type})
+			index = len(result) - 1
+		}
+		result[index].NumVersions++
+		result[index].LatestVersion = p.Version
+	}
+
+	offset, limit, start, end := page(r, len(result))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"packages": result[start:end],
+		"pagination": map[string]int{
+			"offset":      offset,
+			"limit":       limit,
+			"total_items": len(result),
+		},
+	})
+}
+
+func (s *Server) listPackageVersions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	app := s.lookup(w, r)
+	if app == nil {
+		return
+	}
+	packageType, name := r.PathValue("type"), r.PathValue("name")
+	type packageVersion struct {
+		Version string `json:"version"`
+	}
+	bound := false
+	result := []packageVersion{}
+	for _, p := range app.packages {
+		if p.Type != packageType || p.Name != name {
+			continue
+		}
+		bound = true
+		if filter := r.URL.Query().Get("package_version"); filter != "" && p.Version != filter {
+			continue
+		}
+		result = append(result, packageVersion{Version: p.Version})
+	}
+	if !bound {
+		writeNotFound(w, "Package %s:%s is not bound to application '%s'", packageType, name, app.ApplicationKey)
+		return
+	}
+
+	offset, limit, start, end := page(r, len(result))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"versions": result[start:end],
+		"total":    len(result),
+		"offset":   offset,
+		"limit":    limit,
+	})
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Scope      string `json:"scope"`
+		ProjectKey string `json:"project_key"`
+		ExpiresIn  int64  `json:"expires_in"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body.Scope == "" {
+		writeBadRequest(w, "scope", "Scope is required")
+		return
+	}
+	if body.ProjectKey != "" && !s.projects[body.ProjectKey] {
+		writeNotFound(w, "Project '%s' not found", body.ProjectKey)
+		return
+	}
+	s.tokenSerial++
+	created := &token{
+		TokenID:     fmt.Sprintf("fake-token-%d", s.tokenSerial),
+		AccessToken: fmt.Sprintf("fake-access-token-%d", s.tokenSerial),
+		ExpiresIn:   body.ExpiresIn,
+		Scope:       body.Scope,
+		TokenType:   "Bearer",
+	}
+	s.tokens[created.TokenID] = created
+	writeJSON(w, http.StatusOK, created)
+}
+
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokenID := r.PathValue("token_id")
+	if _, ok := s.tokens[tokenID]; !ok {
+		writeNotFound(w, "Token '%s' not found", tokenID)
+		return
+	}
+	delete(s.tokens, tokenID)
+	w.WriteHeader(http.StatusOK)
+}
@@ -39,6 +39,9 @@ var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, erro
 
 // PreCheck This function should be present in every acceptance test.
 func PreCheck(t *testing.T) {
+	if UseFakeServer() {
+		FakeServer()
+	}
 	// Verify required environment variables are set
 	_ = GetArtifactoryUrl(t)
 	_ = GetAccessToken(t)
@@ -110,10 +113,14 @@ func GetTestRestyFromEnv() (*resty.Client, error) {
 	return restyClient, nil
 }
 
-// SkipIfNotAcc skips the test if TF_ACC is not set
+// SkipIfNotAcc skips the test if TF_ACC is not set, unless the tests run against the fake server
 func SkipIfNotAcc(t *testing.T) {
+	if UseFakeServer() {
+		FakeServer()
+		return
+	}
 	if os.Getenv("TF_ACC") == "" {
-		t.Skip("Skipping acceptance test. Set TF_ACC=1 to run.")
+		t.Skip("Skipping acceptance test. Set TF_ACC=1 to run against a platform or APPTRUST_FAKE_SERVER=1 to run offline.")
 	}
 }
 
@@ -0,0 +1,156 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+var _ action.Action = &PromoteAction{}
+var _ action.ActionWithConfigure = &PromoteAction{}
+
+func NewPromoteAction() action.Action {
+	return &PromoteAction{
+		TypeName: "apptrust_promote",
+	}
+}
+
+type PromoteAction struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type PromoteActionModel struct {
+	ApplicationKey             types.String `tfsdk:"application_key"`
+	Version                    types.String `tfsdk:"version"`
+	TargetStage                types.String `tfsdk:"target_stage"`
+	PromotionType              types.String `tfsdk:"promotion_type"`
+	IncludedRepositoryKeys     types.List   `tfsdk:"included_repository_keys"`
+	ExcludedRepositoryKeys     types.List   `tfsdk:"excluded_repository_keys"`
+	PromotionAuthorizationType types.String `tfsdk:"promotion_authorization_type"`
+}
+
+func (a *PromoteAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *PromoteAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Promotes an AppTrust application version to a target lifecycle stage (POST /v1/applications/{application_key}/versions/{version}/promote) " +
+			"and waits for the promotion to complete. Unlike `apptrust_application_version_promotion`, the promotion runs every time the action is invoked. " +
+			"Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application key.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The application version to promote.",
+				Required:    true,
+			},
+			"target_stage": schema.StringAttribute{
+				Description: "Target lifecycle stage (e.g. QA, PROD).",
+				Required:    true,
+			},
+			"promotion_type": schema.StringAttribute{
+				Description: "Promotion type: move, copy, keep, or dry_run. Default is copy.",
+				Optional:    true,
+			},
+			"included_repository_keys": schema.ListAttribute{
+				Description: "Repository keys to include in the promotion.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"excluded_repository_keys": schema.ListAttribute{
+				Description: "Repository keys to exclude from the promotion.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"promotion_authorization_type": schema.StringAttribute{
+				Description: "Promotion authorization type.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *PromoteAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	a.client = client.New(a.ProviderData.Client)
+}
+
+func (a *PromoteAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	a.ProviderData.Usage.RecordResource(a.TypeName, "INVOKE")
+
+	var config PromoteActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promotionType := "copy"
+	if !config.PromotionType.IsNull() {
+		promotionType = config.PromotionType.ValueString()
+	}
+	body := client.PromoteRequest{
+		TargetStage:                config.TargetStage.ValueString(),
+		PromotionType:              promotionType,
+		PromotionAuthorizationType: config.PromotionAuthorizationType.ValueString(),
+	}
+	if !config.IncludedRepositoryKeys.IsNull() {
+		resp.Diagnostics.Append(config.IncludedRepositoryKeys.ElementsAs(ctx, &body.IncludedRepositoryKeys, false)...)
+	}
+	if !config.ExcludedRepositoryKeys.IsNull() {
+		resp.Diagnostics.Append(config.ExcludedRepositoryKeys.ElementsAs(ctx, &body.ExcludedRepositoryKeys, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationKey, version := config.ApplicationKey.ValueString(), config.Version.ValueString()
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	progress(fmt.Sprintf("Promoting %s %s to %s (%s)", applicationKey, version, body.TargetStage, promotionType))
+
+	baseline, err := promotionBaseline(ctx, a.client, applicationKey, version, body.TargetStage)
+	if err == nil {
+		err = a.client.Promotions().Promote(ctx, applicationKey, version, body)
+	}
+	if err == nil && promotionType != "dry_run" {
+		err = waitForPromotion(ctx, a.client, applicationKey, version, body.TargetStage, baseline, progress)
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to promote application version", map[string]interface{}{
+			"application_key": applicationKey,
+			"version":         version,
+			"target_stage":    body.TargetStage,
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "promote", "application version")...)
+	}
+}
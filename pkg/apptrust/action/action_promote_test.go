@@ -0,0 +1,132 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+// Actions and action_trigger blocks require Terraform 1.14 or later.
+var actionsVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+}
+
+// testAccVersionConfig returns the configuration of an application with one version, and the
+// application key and version. The actions under test are triggered by terraform_data.trigger,
+// which the caller declares.
+func testAccVersionConfig() (config, appFqrn, appKey, appVersion string) {
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	versionId, _, _ := testutil.MkNames("test-ver-", "apptrust_application_version")
+	appKey = fmt.Sprintf("app-%d", id)
+	appVersion = fmt.Sprintf("1.0.%d", versionId)
+
+	config = fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_application_version" "version" {
+			application_key   = apptrust_application.%s.application_key
+			version           = "%s"
+			tag               = "acc-test"
+			source_artifacts  = [{ path = "generic-repo/readme.md" }]
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, appName, appVersion)
+	return config, appFqrn, appKey, appVersion
+}
+
+// promotionsConfig reads the newest promotion record of the version once the actions have run.
+const promotionsConfig = `
+	data "apptrust_application_version_promotions" "check" {
+		application_key = apptrust_application_version.version.application_key
+		version         = apptrust_application_version.version.version
+		depends_on      = [terraform_data.trigger]
+	}
+`
+
+// TestAccPromoteAction_basic promotes a version from an action_trigger and checks the promotion record.
+// Requires lifecycle stage (e.g. QA) to exist in the project. Set APPTRUST_TEST_TARGET_STAGE to match your project.
+func TestAccPromoteAction_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	targetStage := os.Getenv("APPTRUST_TEST_TARGET_STAGE")
+	if targetStage == "" {
+		targetStage = "QA"
+	}
+	versionConfig, appFqrn, appKey, appVersion := testAccVersionConfig()
+
+	config := fmt.Sprintf(`
+		%s
+
+		action "apptrust_promote" "promote" {
+			config {
+				application_key = apptrust_application_version.version.application_key
+				version         = apptrust_application_version.version.version
+				target_stage    = "%s"
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = apptrust_application_version.version.version
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.apptrust_promote.promote]
+				}
+			}
+		}
+	`, versionConfig, targetStage)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks:   actionsVersionChecks,
+		CheckDestroy:             acctest.TestAccCheckApplicationDestroy(appFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + promotionsConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.apptrust_application_version_promotions.check",
+						tfjsonpath.New("promotions").AtSliceIndex(0),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"application_key":     knownvalue.StringExact(appKey),
+							"application_version": knownvalue.StringExact(appVersion),
+							"target_stage":        knownvalue.StringExact(targetStage),
+							"status":              knownvalue.StringExact("COMPLETED"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+// releaseStage is the stage a release promotes the version to.
+const releaseStage = "PROD"
+
+var _ action.Action = &ReleaseAction{}
+var _ action.ActionWithConfigure = &ReleaseAction{}
+
+func NewReleaseAction() action.Action {
+	return &ReleaseAction{
+		TypeName: "apptrust_release",
+	}
+}
+
+type ReleaseAction struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type ReleaseActionModel struct {
+	ApplicationKey             types.String `tfsdk:"application_key"`
+	Version                    types.String `tfsdk:"version"`
+	PromotionType              types.String `tfsdk:"promotion_type"`
+	IncludedRepositoryKeys     types.List   `tfsdk:"included_repository_keys"`
+	ExcludedRepositoryKeys     types.List   `tfsdk:"excluded_repository_keys"`
+	PromotionAuthorizationType types.String `tfsdk:"promotion_authorization_type"`
+}
+
+func (a *ReleaseAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *ReleaseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Releases an AppTrust application version to the PROD stage (POST /v1/applications/{application_key}/versions/{version}/release) " +
+			"and waits for the release to complete. Unlike `apptrust_application_version_release`, the release runs every time the action is invoked. " +
+			"Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application key.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The application version to release.",
+				Required:    true,
+			},
+			"promotion_type": schema.StringAttribute{
+				Description: "Promotion type: move, copy, keep, or dry_run. Default is copy.",
+				Optional:    true,
+			},
+			"included_repository_keys": schema.ListAttribute{
+				Description: "Repository keys to include.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"excluded_repository_keys": schema.ListAttribute{
+				Description: "Repository keys to exclude.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"promotion_authorization_type": schema.StringAttribute{
+				Description: "Promotion authorization type.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *ReleaseAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	a.client = client.New(a.ProviderData.Client)
+}
+
+func (a *ReleaseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	a.ProviderData.Usage.RecordResource(a.TypeName, "INVOKE")
+
+	var config ReleaseActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	promotionType := "copy"
+	if !config.PromotionType.IsNull() {
+		promotionType = config.PromotionType.ValueString()
+	}
+	body := client.ReleaseRequest{
+		PromotionType:              promotionType,
+		PromotionAuthorizationType: config.PromotionAuthorizationType.ValueString(),
+	}
+	if !config.IncludedRepositoryKeys.IsNull() {
+		resp.Diagnostics.Append(config.IncludedRepositoryKeys.ElementsAs(ctx, &body.IncludedRepositoryKeys, false)...)
+	}
+	if !config.ExcludedRepositoryKeys.IsNull() {
+		resp.Diagnostics.Append(config.ExcludedRepositoryKeys.ElementsAs(ctx, &body.ExcludedRepositoryKeys, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationKey, version := config.ApplicationKey.ValueString(), config.Version.ValueString()
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	progress(fmt.Sprintf("Releasing %s %s (%s)", applicationKey, version, promotionType))
+
+	baseline, err := promotionBaseline(ctx, a.client, applicationKey, version, releaseStage)
+	if err == nil {
+		err = a.client.Promotions().Release(ctx, applicationKey, version, body)
+	}
+	if err == nil && promotionType != "dry_run" {
+		err = waitForPromotion(ctx, a.client, applicationKey, version, releaseStage, baseline, progress)
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to release application version", map[string]interface{}{
+			"application_key": applicationKey,
+			"version":         version,
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "release", "application version")...)
+	}
+}
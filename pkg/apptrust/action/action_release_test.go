@@ -0,0 +1,88 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
+)
+
+// TestAccReleaseAction_basic releases a version from an action_trigger and checks the promotion record.
+// Requires PROD stage and release policies in the project. Set APPTRUST_TEST_RELEASE=1 to run.
+func TestAccReleaseAction_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	if os.Getenv("APPTRUST_TEST_RELEASE") == "" {
+		t.Skip("Set APPTRUST_TEST_RELEASE=1 to run the release action acceptance test (requires PROD stage)")
+	}
+	versionConfig, appFqrn, appKey, appVersion := testAccVersionConfig()
+
+	config := fmt.Sprintf(`
+		%s
+
+		action "apptrust_release" "release" {
+			config {
+				application_key = apptrust_application_version.version.application_key
+				version         = apptrust_application_version.version.version
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = apptrust_application_version.version.version
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.apptrust_release.release]
+				}
+			}
+		}
+	`, versionConfig)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks:   actionsVersionChecks,
+		CheckDestroy:             acctest.TestAccCheckApplicationDestroy(appFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + promotionsConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.apptrust_application_version_promotions.check",
+						tfjsonpath.New("promotions").AtSliceIndex(0),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"application_key":     knownvalue.StringExact(appKey),
+							"application_version": knownvalue.StringExact(appVersion),
+							"target_stage":        knownvalue.StringExact("PROD"),
+							"status":              knownvalue.StringExact("COMPLETED"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
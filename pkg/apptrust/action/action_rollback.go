@@ -0,0 +1,116 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+var _ action.Action = &RollbackAction{}
+var _ action.ActionWithConfigure = &RollbackAction{}
+
+func NewRollbackAction() action.Action {
+	return &RollbackAction{
+		TypeName: "apptrust_rollback",
+	}
+}
+
+type RollbackAction struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type RollbackActionModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Version        types.String `tfsdk:"version"`
+	FromStage      types.String `tfsdk:"from_stage"`
+}
+
+func (a *RollbackAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.TypeName
+}
+
+func (a *RollbackAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rolls back an AppTrust application version from a lifecycle stage (POST /v1/applications/{application_key}/versions/{version}/rollback) " +
+			"and waits for the rollback to complete. Unlike `apptrust_application_version_rollback`, the rollback runs every time the action is invoked. " +
+			"Requires Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application key.",
+				Required:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The application version to roll back.",
+				Required:    true,
+			},
+			"from_stage": schema.StringAttribute{
+				Description: "Stage from which to roll back (e.g. qa, PROD).",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *RollbackAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	a.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	a.client = client.New(a.ProviderData.Client)
+}
+
+func (a *RollbackAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	a.ProviderData.Usage.RecordResource(a.TypeName, "INVOKE")
+
+	var config RollbackActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationKey, version := config.ApplicationKey.ValueString(), config.Version.ValueString()
+	body := client.RollbackRequest{FromStage: config.FromStage.ValueString()}
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	progress(fmt.Sprintf("Rolling back %s %s from %s", applicationKey, version, body.FromStage))
+
+	baseline, err := promotionBaseline(ctx, a.client, applicationKey, version, body.FromStage)
+	if err == nil {
+		err = a.client.Promotions().Rollback(ctx, applicationKey, version, body)
+	}
+	if err == nil {
+		err = waitForPromotion(ctx, a.client, applicationKey, version, body.FromStage, baseline, progress)
+	}
+	if err != nil {
+		tflog.Error(ctx, "Failed to roll back application version", map[string]interface{}{
+			"application_key": applicationKey,
+			"version":         version,
+			"from_stage":      body.FromStage,
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "rollback", "application version")...)
+	}
+}
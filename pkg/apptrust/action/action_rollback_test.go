@@ -0,0 +1,99 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
+)
+
+// TestAccRollbackAction_basic promotes and then rolls back a version from one action_trigger and checks the
+// rollback record.
+// Requires lifecycle stage (e.g. QA) to exist in the project. Set APPTRUST_TEST_TARGET_STAGE to match your project.
+func TestAccRollbackAction_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	targetStage := os.Getenv("APPTRUST_TEST_TARGET_STAGE")
+	if targetStage == "" {
+		targetStage = "QA"
+	}
+	versionConfig, appFqrn, appKey, appVersion := testAccVersionConfig()
+
+	config := fmt.Sprintf(`
+		%s
+
+		action "apptrust_promote" "promote" {
+			config {
+				application_key = apptrust_application_version.version.application_key
+				version         = apptrust_application_version.version.version
+				target_stage    = "%s"
+			}
+		}
+
+		action "apptrust_rollback" "rollback" {
+			config {
+				application_key = apptrust_application_version.version.application_key
+				version         = apptrust_application_version.version.version
+				from_stage      = "%s"
+			}
+		}
+
+		resource "terraform_data" "trigger" {
+			input = apptrust_application_version.version.version
+
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.apptrust_promote.promote, action.apptrust_rollback.rollback]
+				}
+			}
+		}
+	`, versionConfig, targetStage, targetStage)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks:   actionsVersionChecks,
+		CheckDestroy:             acctest.TestAccCheckApplicationDestroy(appFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config: config + promotionsConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.apptrust_application_version_promotions.check",
+						tfjsonpath.New("promotions").AtSliceIndex(0),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"application_key":     knownvalue.StringExact(appKey),
+							"application_version": knownvalue.StringExact(appVersion),
+							"source_stage":        knownvalue.StringExact(targetStage),
+							"status":              knownvalue.StringExact("COMPLETED"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+// promotionPollInterval is how often the promotion records are polled while a promotion, release
+// or rollback is still running.
+var promotionPollInterval = 2 * time.Second
+
+// promotionWaitTimeout bounds how long waitForPromotion waits for the promotion record of the
+// operation to appear and reach a final status.
+var promotionWaitTimeout = 30 * time.Minute
+
+// latestPromotion returns the newest promotion record of the version involving stage, or nil
+// when there is none.
+func latestPromotion(ctx context.Context, c client.Client, applicationKey, version, stage string) (*client.Promotion, error) {
+	limit, orderAsc := int64(1), false
+	promotions, err := c.Promotions().List(ctx, applicationKey, version, client.PromotionListOptions{
+		FilterBy:   stage,
+		OrderBy:    "created",
+		OrderAsc:   &orderAsc,
+		Pagination: client.Pagination{Limit: &limit},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(promotions.Promotions) == 0 {
+		return nil, nil
+	}
+	return &promotions.Promotions[0], nil
+}
+
+// promotionBaseline returns the creation time, in the platform clock, of the newest promotion
+// record involving stage. It is read before a promotion, release or rollback is started, so that
+// waitForPromotion ignores the records of earlier operations.
+func promotionBaseline(ctx context.Context, c client.Client, applicationKey, version, stage string) (int64, error) {
+	latest, err := latestPromotion(ctx, c, applicationKey, version, stage)
+	if err != nil || latest == nil {
+		return 0, err
+	}
+	return latest.CreatedMillis, nil
+}
+
+// waitForPromotion reports the status of the promotion record of the operation involving stage
+// through progress until it is COMPLETED or FAILED. The platform runs large promotions
+// asynchronously, so the POST returning is not the end of the operation, and the record may only
+// be listed some time later. Records created up to baseline (see promotionBaseline) belong to
+// earlier operations, so polling goes on until a newer record appears. A dry run creates no
+// record and must not be waited for. It gives up after promotionWaitTimeout.
+func waitForPromotion(ctx context.Context, c client.Client, applicationKey, version, stage string, baseline int64, progress func(string)) error {
+	deadline := time.Now().Add(promotionWaitTimeout)
+	reported := ""
+	for {
+		latest, err := latestPromotion(ctx, c, applicationKey, version, stage)
+		if err != nil {
+			return err
+		}
+		if latest != nil && latest.CreatedMillis <= baseline {
+			latest = nil
+		}
+
+		if latest != nil {
+			if latest.Status != reported {
+				progress(fmt.Sprintf("%s %s: %s -> %s %s", applicationKey, version, latest.SourceStage, latest.TargetStage, latest.Status))
+				reported = latest.Status
+			}
+			switch latest.Status {
+			case "COMPLETED":
+				return nil
+			case "FAILED":
+				return errors.New(promotionFailure(*latest))
+			}
+		}
+
+		if time.Now().After(deadline) {
+			if latest == nil {
+				return fmt.Errorf("no promotion record of %s %s involving stage %s appeared within %s", applicationKey, version, stage, promotionWaitTimeout)
+			}
+			return fmt.Errorf("promotion of %s %s from %s to %s is still %s after %s", applicationKey, version, latest.SourceStage, latest.TargetStage, latest.Status, promotionWaitTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(promotionPollInterval):
+		}
+	}
+}
+
+// promotionFailure describes a failed promotion record, including the messages the platform
+// attached to it.
+func promotionFailure(p client.Promotion) string {
+	message := fmt.Sprintf("promotion of %s %s from %s to %s failed", p.ApplicationKey, p.ApplicationVersion, p.SourceStage, p.TargetStage)
+	for _, m := range p.Messages {
+		message += ": " + m.Text
+	}
+	return message
+}
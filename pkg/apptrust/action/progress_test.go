@@ -0,0 +1,170 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest/fakeserver"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+// shortPromotionWait polls the promotion records quickly and gives up after a short time for the
+// duration of the test.
+func shortPromotionWait(t *testing.T) {
+	t.Helper()
+	pollInterval, waitTimeout := promotionPollInterval, promotionWaitTimeout
+	promotionPollInterval, promotionWaitTimeout = time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() {
+		promotionPollInterval, promotionWaitTimeout = pollInterval, waitTimeout
+	})
+}
+
+func TestWaitForPromotion(t *testing.T) {
+	ctx := context.Background()
+	server := fakeserver.New("aa")
+	t.Cleanup(server.Close)
+	c := client.New(resty.New().SetBaseURL(server.URL).SetAuthToken(fakeserver.AccessToken))
+
+	if _, err := c.Applications().Create(ctx, client.Application{ApplicationKey: "app-1", ApplicationName: "app-1", ProjectKey: "aa"}); err != nil {
+		t.Fatal(err)
+	}
+	err := c.Versions().Create(ctx, "app-1", client.CreateVersionRequest{
+		Version: "1.0.0",
+		Sources: client.VersionSources{Artifacts: []client.VersionSourceArtifact{{Path: "generic-repo/readme.md"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shortPromotionWait(t)
+	var messages []string
+	progress := func(message string) { messages = append(messages, message) }
+
+	if err := waitForPromotion(ctx, c, "app-1", "1.0.0", "QA", 0, progress); err == nil || !strings.Contains(err.Error(), "no promotion record") || len(messages) != 0 {
+		t.Fatalf("expected to time out without a promotion record, got %v (%v)", messages, err)
+	}
+
+	if err := c.Promotions().Promote(ctx, "app-1", "1.0.0", client.PromoteRequest{TargetStage: "QA"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitForPromotion(ctx, c, "app-1", "1.0.0", "QA", 0, progress); err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || !strings.HasSuffix(messages[0], "-> QA COMPLETED") {
+		t.Errorf("expected one COMPLETED progress message, got %v", messages)
+	}
+
+	// The record of the earlier promotion is ignored once it is the baseline.
+	baseline, err := promotionBaseline(ctx, c, "app-1", "1.0.0", "QA")
+	if err != nil || baseline == 0 {
+		t.Fatalf("expected the earlier promotion as the baseline, got %d (%v)", baseline, err)
+	}
+	messages = nil
+	if err := waitForPromotion(ctx, c, "app-1", "1.0.0", "QA", baseline, progress); err == nil || len(messages) != 0 {
+		t.Errorf("expected the earlier promotion record to be ignored, got %v (%v)", messages, err)
+	}
+
+	if err := c.Promotions().Rollback(ctx, "app-1", "1.0.0", client.RollbackRequest{FromStage: "QA"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitForPromotion(ctx, c, "app-1", "1.0.0", "QA", baseline, progress); err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || !strings.Contains(messages[0], "QA -> ") {
+		t.Errorf("expected the progress of the rollback, got %v", messages)
+	}
+}
+
+func TestWaitForPromotion_recordListedLate(t *testing.T) {
+	shortPromotionWait(t)
+	// The earlier record is listed until the new one shows up, which then runs for a while.
+	responses := []string{
+		`{"promotions":[{"target_stage":"QA","status":"COMPLETED","created_millis":1}],"total":1}`,
+		`{"promotions":[{"target_stage":"QA","status":"COMPLETED","created_millis":1}],"total":1}`,
+		`{"promotions":[{"source_stage":"DEV","target_stage":"QA","status":"IN_PROGRESS","created_millis":2}],"total":2}`,
+		`{"promotions":[{"source_stage":"DEV","target_stage":"QA","status":"COMPLETED","created_millis":2}],"total":2}`,
+	}
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := min(int(requests.Add(1))-1, len(responses)-1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[i]))
+	}))
+	defer server.Close()
+
+	var messages []string
+	c := client.New(resty.New().SetBaseURL(server.URL))
+	err := waitForPromotion(context.Background(), c, "app-1", "1.0.0", "QA", 1, func(message string) { messages = append(messages, message) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests.Load() != 4 || len(messages) != 2 || !strings.HasSuffix(messages[0], "DEV -> QA IN_PROGRESS") || !strings.HasSuffix(messages[1], "DEV -> QA COMPLETED") {
+		t.Errorf("expected to wait for the new record to complete, got %v after %d requests", messages, requests.Load())
+	}
+}
+
+func TestWaitForPromotion_timeout(t *testing.T) {
+	shortPromotionWait(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"promotions":[{"source_stage":"DEV","target_stage":"QA","status":"IN_PROGRESS","created_millis":2}],"total":1}`))
+	}))
+	defer server.Close()
+
+	c := client.New(resty.New().SetBaseURL(server.URL))
+	err := waitForPromotion(context.Background(), c, "app-1", "1.0.0", "QA", 0, func(string) {})
+	if err == nil || !strings.Contains(err.Error(), "is still IN_PROGRESS after") {
+		t.Errorf("expected a timeout of the running promotion, got %v", err)
+	}
+}
+
+func TestLatestPromotion_orderedByCreated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("order_by") != "created" || query.Get("order_asc") != "false" || query.Get("limit") != "1" || query.Get("filter_by") != "QA" {
+			t.Errorf("expected the newest record involving QA to be requested, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"promotions":[{"target_stage":"QA","status":"IN_PROGRESS","created_millis":2}],"total":2}`))
+	}))
+	defer server.Close()
+
+	c := client.New(resty.New().SetBaseURL(server.URL))
+	latest, err := latestPromotion(context.Background(), c, "app-1", "1.0.0", "QA")
+	if err != nil || latest == nil || latest.CreatedMillis != 2 {
+		t.Errorf("unexpected latest promotion %+v (%v)", latest, err)
+	}
+}
+
+func TestPromotionFailure(t *testing.T) {
+	message := promotionFailure(client.Promotion{
+		ApplicationKey:     "app-1",
+		ApplicationVersion: "1.0.0",
+		SourceStage:        "DEV",
+		TargetStage:        "QA",
+		Messages:           []client.PromotionMessage{{Text: "policy violation"}},
+	})
+	if message != "promotion of app-1 1.0.0 from DEV to QA failed: policy violation" {
+		t.Errorf("unexpected message %q", message)
+	}
+}
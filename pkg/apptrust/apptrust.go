@@ -22,39 +22,11 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/samber/lo"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
-type apptrustError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Field   string `json:"field"`
-}
-
-func (e apptrustError) String() string {
-	if e.Field != "" {
-		return fmt.Sprintf("%s: %s (%s)", e.Field, e.Message, e.Code)
-	}
-	if e.Code != "" {
-		return fmt.Sprintf("%s - %s", e.Code, e.Message)
-	}
-	return e.Message
-}
-
-type AppTrustErrorsResponse struct {
-	Errors []apptrustError `json:"errors"`
-}
-
-func (r AppTrustErrorsResponse) String() string {
-	errs := lo.Reduce(r.Errors, func(err string, item apptrustError, _ int) string {
-		if err == "" {
-			return item.String()
-		} else {
-			return fmt.Sprintf("%s, %s", err, item.String())
-		}
-	}, "")
-	return errs
-}
+// AppTrustErrorsResponse is the error body returned by the AppTrust API.
+type AppTrustErrorsResponse = client.ErrorsResponse
 
 // HandleAPIError processes API errors and returns appropriate diagnostics.
 // It provides user-friendly error messages based on HTTP status codes.
@@ -104,54 +76,200 @@ func HandleAPIErrorWithType(response *resty.Response, operation string, resource
 	statusCode := response.StatusCode()
 	errorDetail := apiErrorDetail(response)
 
+	summary := apiErrorSummary(statusCode)
+	var detail string
 	switch statusCode {
 	case http.StatusBadRequest:
 		if errorDetail != "" {
-			diags.AddError("Invalid Request", fmt.Sprintf("Failed to %s %s: %s", operation, resourceType, errorDetail))
+			detail = fmt.Sprintf("Failed to %s %s: %s", operation, resourceType, errorDetail)
 		} else {
-			diags.AddError("Invalid Request", fmt.Sprintf("Failed to %s %s: The request was invalid (no details from server).", operation, resourceType))
+			detail = fmt.Sprintf("Failed to %s %s: The request was invalid (no details from server).", operation, resourceType)
 		}
 	case http.StatusUnauthorized:
 		if errorDetail != "" {
-			diags.AddError("Authentication Failed", errorDetail)
+			detail = errorDetail
 		} else {
-			diags.AddError("Authentication Failed", "Invalid credentials (no details from server).")
+			detail = "Invalid credentials (no details from server)."
 		}
 	case http.StatusForbidden:
 		if errorDetail != "" {
-			diags.AddError("Permission Denied", errorDetail)
+			detail = errorDetail
 		} else {
-			diags.AddError("Permission Denied", fmt.Sprintf("You do not have permission to %s %s.", operation, resourceType))
+			detail = fmt.Sprintf("You do not have permission to %s %s.", operation, resourceType)
 		}
 	case http.StatusNotFound:
 		if errorDetail != "" {
-			diags.AddError("Resource Not Found", errorDetail)
+			detail = errorDetail
 		} else {
-			diags.AddError("Resource Not Found", fmt.Sprintf("The %s was not found during %s.", resourceType, operation))
+			detail = fmt.Sprintf("The %s was not found during %s.", resourceType, operation)
 		}
 	case http.StatusConflict:
 		if errorDetail != "" {
-			diags.AddError("Resource Conflict", errorDetail)
+			detail = errorDetail
 		} else {
-			diags.AddError("Resource Conflict", fmt.Sprintf("A conflict occurred during %s %s.", operation, resourceType))
+			detail = fmt.Sprintf("A conflict occurred during %s %s.", operation, resourceType)
 		}
 	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
 		if errorDetail != "" {
-			diags.AddError("Server Error", fmt.Sprintf("Server error (Status: %d): %s", statusCode, errorDetail))
+			detail = fmt.Sprintf("Server error (Status: %d): %s", statusCode, errorDetail)
 		} else {
-			diags.AddError("Server Error", fmt.Sprintf("Server error during %s %s (Status: %d).", operation, resourceType, statusCode))
+			detail = fmt.Sprintf("Server error during %s %s (Status: %d).", operation, resourceType, statusCode)
 		}
 	default:
 		if errorDetail != "" {
-			diags.AddError("API Error", fmt.Sprintf("Unexpected error (Status: %d): %s", statusCode, errorDetail))
+			detail = fmt.Sprintf("Unexpected error (Status: %d): %s", statusCode, errorDetail)
 		} else {
-			diags.AddError("API Error", fmt.Sprintf("Unexpected error during %s %s (Status: %d).", operation, resourceType, statusCode))
+			detail = fmt.Sprintf("Unexpected error during %s %s (Status: %d).", operation, resourceType, statusCode)
+		}
+	}
+
+	diags.AddError(summary, withErrorContext(detail, response, operation, resourceType))
+	return diags
+}
+
+// HandleAPIErrorWithPaths processes API errors like HandleAPIErrorWithType, but returns the errors
+// whose field is in fields as attribute errors, so Terraform shows them at the offending attribute
+// of the configuration. Responses without such errors get the diagnostics of HandleAPIErrorWithType.
+func HandleAPIErrorWithPaths(response *resty.Response, operation string, resourceType string, fields FieldPaths) diag.Diagnostics {
+	var body AppTrustErrorsResponse
+	if err := json.Unmarshal(response.Body(), &body); err != nil {
+		return HandleAPIErrorWithType(response, operation, resourceType)
+	}
+
+	var diags diag.Diagnostics
+	var unmapped []client.Error
+	summary := apiErrorSummary(response.StatusCode())
+	for _, apiErr := range body.Errors {
+		attributePath, ok := fields.Path(apiErr.Field)
+		if apiErr.Field == "" || !ok {
+			unmapped = append(unmapped, apiErr)
+			continue
 		}
+		message := apiErr.Message
+		if message == "" {
+			message = apiErr.Code
+		}
+		diags.Append(diag.NewAttributeErrorDiagnostic(
+			attributePath,
+			summary,
+			withErrorContext(fmt.Sprintf("Failed to %s %s: %s", operation, resourceType, message), response, operation, resourceType),
+		))
+	}
+	if !diags.HasError() {
+		return HandleAPIErrorWithType(response, operation, resourceType)
+	}
+	if len(unmapped) > 0 {
+		detail := fmt.Sprintf("Failed to %s %s: %s", operation, resourceType, AppTrustErrorsResponse{Errors: unmapped}.String())
+		diags.AddError(summary, withErrorContext(detail, response, operation, resourceType))
+	}
+	return diags
+}
+
+// HandleClientError converts an error returned by the typed client to diagnostics. API errors get
+// the diagnostics of HandleAPIErrorWithType, and other errors, such as a network error, an error
+// naming the failed operation.
+func HandleClientError(err error, operation string, resourceType string) diag.Diagnostics {
+	if apiErr, ok := client.AsAPIError(err); ok {
+		return HandleAPIErrorWithType(apiErr.Response, operation, resourceType)
+	}
+	return clientErrorDiagnostics(err, operation, resourceType)
+}
+
+// HandleClientErrorWithPaths is HandleClientError for requests sending the attributes in fields.
+// API errors get the diagnostics of HandleAPIErrorWithPaths.
+func HandleClientErrorWithPaths(err error, operation string, resourceType string, fields FieldPaths) diag.Diagnostics {
+	if apiErr, ok := client.AsAPIError(err); ok {
+		return HandleAPIErrorWithPaths(apiErr.Response, operation, resourceType, fields)
 	}
+	return clientErrorDiagnostics(err, operation, resourceType)
+}
 
+// clientErrorDiagnostics returns the diagnostics of a client error without an API response. The
+// error of a request that got no response includes the number of attempts, see
+// client.RequestError.
+func clientErrorDiagnostics(err error, operation string, resourceType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.AddError(
+		fmt.Sprintf("Unable to %s %s", titleCase(operation), titleCase(resourceType)),
+		fmt.Sprintf("Failed to %s %s: %s", operation, resourceType, err),
+	)
 	return diags
 }
 
+// titleCase upper-cases the first letter of every word of s.
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// apiErrorSummary returns the diagnostic summary of an API error status code.
+func apiErrorSummary(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return "Invalid Request"
+	case http.StatusUnauthorized:
+		return "Authentication Failed"
+	case http.StatusForbidden:
+		return "Permission Denied"
+	case http.StatusNotFound:
+		return "Resource Not Found"
+	case http.StatusConflict:
+		return "Resource Conflict"
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return "Server Error"
+	default:
+		return "API Error"
+	}
+}
+
+// requestIDHeaders are the response headers carrying the ID the platform logs a request with, in
+// order of preference. Support asks for it to find the request in the platform logs.
+var requestIDHeaders = []string{"X-Request-Id", "X-JFrog-Request-Id", "X-B3-TraceId"}
+
+// withErrorContext appends to the detail of an API error diagnostic the number of attempts, a
+// remediation hint from the catalog and the request ID, when known.
+func withErrorContext(detail string, response *resty.Response, operation string, resourceType string) string {
+	// Retryable failures reach this point only after the provider retry policy gave up.
+	if attempts := requestAttempts(response); attempts > 1 {
+		detail = fmt.Sprintf("%s\n\nThe request failed after %d attempts.", detail, attempts)
+	}
+
+	var body AppTrustErrorsResponse
+	_ = json.Unmarshal(response.Body(), &body)
+	if hint := remediationHint(response.StatusCode(), operation, resourceType, body.Errors); hint != "" {
+		detail = fmt.Sprintf("%s\n\n%s", detail, hint)
+	}
+
+	if id := requestID(response); id != "" {
+		detail = fmt.Sprintf("%s\n\nRequest ID: %s", detail, id)
+	}
+	return detail
+}
+
+// requestID returns the platform request ID of the response, or "".
+func requestID(response *resty.Response) string {
+	if response == nil || response.RawResponse == nil {
+		return ""
+	}
+	for _, header := range requestIDHeaders {
+		if id := response.Header().Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// requestAttempts returns how many times resty sent the request that produced the response.
+func requestAttempts(response *resty.Response) int {
+	if response == nil || response.Request == nil {
+		return 0
+	}
+	return response.Request.Attempt
+}
+
 // extractUserFriendlyError safely extracts user-friendly error messages from API responses.
 func extractUserFriendlyError(response *resty.Response) string {
 	body := response.Body()
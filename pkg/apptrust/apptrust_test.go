@@ -0,0 +1,50 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+func TestHandleClientError(t *testing.T) {
+	response := testResponse(http.StatusBadRequest, `{"errors":[{"code":"invalid","message":"must not be blank","field":"application_name"}]}`)
+	apiErr := fmt.Errorf("creating application: %w", &client.APIError{Response: response, StatusCode: http.StatusBadRequest})
+
+	if got, want := apptrust.HandleClientError(apiErr, "create", "application"), apptrust.HandleAPIErrorWithType(response, "create", "application"); !got.Equal(want) {
+		t.Errorf("expected the HandleAPIErrorWithType diagnostics %v, got %v", want, got)
+	}
+	if got, want := apptrust.HandleClientErrorWithPaths(apiErr, "create", "application", testFieldPaths), apptrust.HandleAPIErrorWithPaths(response, "create", "application", testFieldPaths); !got.Equal(want) {
+		t.Errorf("expected the HandleAPIErrorWithPaths diagnostics %v, got %v", want, got)
+	}
+
+	diags := apptrust.HandleClientError(errors.New("connection refused"), "create", "application version")
+	if len(diags) != 1 || diags[0].Summary() != "Unable to Create Application Version" {
+		t.Fatalf("expected a single error naming the operation, got %v", diags)
+	}
+	if want := "Failed to create application version: connection refused"; diags[0].Detail() != want {
+		t.Errorf("expected the detail %q, got %q", want, diags[0].Detail())
+	}
+
+	diags = apptrust.HandleClientError(&client.RequestError{Attempts: 3, Err: errors.New("connection refused")}, "read", "application")
+	if want := "Failed to read application: connection refused (the request failed after 3 attempts)"; diags[0].Detail() != want {
+		t.Errorf("expected the detail %q, got %q", want, diags[0].Detail())
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Capability is an AppTrust feature that is not available on every platform version supported by
+// the provider.
+type Capability string
+
+// capabilityMinArtifactoryVersions is the registry of capabilities and the first Artifactory
+// version supporting them. Add an entry, citing the release notes that introduced the feature,
+// gate the attribute with Capabilities.CheckAttribute and build its description with
+// Capability.Requirement whenever a resource starts using an API field newer than
+// MinArtifactoryVersion. Every attribute in use today is supported by MinArtifactoryVersion.
+var capabilityMinArtifactoryVersions = map[Capability]string{}
+
+// MinArtifactoryVersion returns the first Artifactory version supporting the capability, or an
+// empty string for a capability missing from the registry.
+func (c Capability) MinArtifactoryVersion() string {
+	return capabilityMinArtifactoryVersions[c]
+}
+
+// Requirement returns the sentence appended to the description of an attribute gated by the
+// capability, so schema descriptions and docs follow the registry.
+func (c Capability) Requirement() string {
+	return fmt.Sprintf("Requires Artifactory %s or later.", c.MinArtifactoryVersion())
+}
+
+// Capabilities tells which capabilities the configured platform supports, based on the
+// Artifactory version detected during provider Configure.
+type Capabilities struct {
+	artifactoryVersion *version.Version
+}
+
+// NewCapabilities returns the capabilities of a platform running artifactoryVersion. When the
+// version is empty (skip_version_check) or cannot be parsed, every capability is assumed supported
+// and the API remains the judge.
+func NewCapabilities(artifactoryVersion string) Capabilities {
+	parsed, err := version.NewVersion(artifactoryVersion)
+	if err != nil {
+		return Capabilities{}
+	}
+	return Capabilities{artifactoryVersion: parsed}
+}
+
+// Supports reports whether the platform supports capability.
+func (c Capabilities) Supports(capability Capability) bool {
+	if c.artifactoryVersion == nil {
+		return true
+	}
+	minVersion, ok := capabilityMinArtifactoryVersions[capability]
+	if !ok {
+		return true
+	}
+	return !c.artifactoryVersion.LessThan(version.Must(version.NewVersion(minVersion)))
+}
+
+// CheckAttribute adds a plan-time error to diags when the attribute at attributePath is set in
+// config but the platform does not support capability. The error names the minimum Artifactory
+// version, instead of the request failing with a 400 at apply time.
+func (c Capabilities) CheckAttribute(ctx context.Context, config tfsdk.Config, attributePath path.Path, capability Capability, diags *diag.Diagnostics) {
+	if c.Supports(capability) {
+		return
+	}
+
+	// Read the value as attr.Value so the check works for any attribute type.
+	var value attr.Value
+	if d := config.GetAttribute(ctx, attributePath, &value); d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	diags.AddAttributeError(
+		attributePath,
+		"Unsupported platform version",
+		fmt.Sprintf("%s requires Artifactory version %s or higher. Current version: %s. Remove the attribute or upgrade the JFrog Platform.",
+			attributePath, capability.MinArtifactoryVersion(), c.artifactoryVersion),
+	)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCapability registers a capability first supported by Artifactory 7.130.0 for the duration
+// of the test.
+func testCapability(t *testing.T) Capability {
+	t.Helper()
+	const capability = Capability("test_capability")
+	capabilityMinArtifactoryVersions[capability] = "7.130.0"
+	t.Cleanup(func() { delete(capabilityMinArtifactoryVersions, capability) })
+	return capability
+}
+
+func TestCapabilities_Supports(t *testing.T) {
+	capability := testCapability(t)
+	tests := []struct {
+		artifactoryVersion string
+		capability         Capability
+		want               bool
+	}{
+		{"7.125.0", capability, false},
+		{"7.129.9", capability, false},
+		{"7.130.0", capability, true},
+		{"7.133.1", capability, true},
+		// Without a detected version (skip_version_check) the API is the judge.
+		{"", capability, true},
+		{"not-a-version", capability, true},
+		{"7.100.0", Capability("unknown"), true},
+	}
+	for _, tt := range tests {
+		if got := NewCapabilities(tt.artifactoryVersion).Supports(tt.capability); got != tt.want {
+			t.Errorf("NewCapabilities(%q).Supports(%q) = %t, want %t", tt.artifactoryVersion, tt.capability, got, tt.want)
+		}
+	}
+}
+
+func TestCapabilities_CheckAttribute(t *testing.T) {
+	ctx := context.Background()
+	capability := testCapability(t)
+	configSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"gated": schema.StringAttribute{Optional: true},
+	}}
+	config := func(value interface{}) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: configSchema,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"gated": tftypes.String}}, map[string]tftypes.Value{
+				"gated": tftypes.NewValue(tftypes.String, value),
+			}),
+		}
+	}
+
+	tests := []struct {
+		name               string
+		artifactoryVersion string
+		value              interface{}
+		wantErr            bool
+	}{
+		{"older version", "7.125.0", "value", true},
+		{"older version, attribute not set", "7.125.0", nil, false},
+		{"older version, unknown value", "7.125.0", tftypes.UnknownValue, false},
+		{"minimum version", "7.130.0", "value", false},
+		{"no detected version", "", "value", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			NewCapabilities(tt.artifactoryVersion).CheckAttribute(ctx, config(tt.value), path.Root("gated"), capability, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected an error: %t, got %v", tt.wantErr, diags)
+			}
+			if tt.wantErr && !strings.Contains(diags[0].Detail(), "requires Artifactory version 7.130.0 or higher. Current version: 7.125.0") {
+				t.Errorf("expected the minimum and current versions in %q", diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestCapability_Requirement(t *testing.T) {
+	capability := testCapability(t)
+	if got := capability.Requirement(); got != "Requires Artifactory 7.130.0 or later." {
+		t.Errorf("unexpected requirement %q", got)
+	}
+	if got := Capability("unknown").MinArtifactoryVersion(); got != "" {
+		t.Errorf("expected no minimum version for an unknown capability, got %q", got)
+	}
+}
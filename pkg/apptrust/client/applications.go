@@ -0,0 +1,184 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ApplicationsService manages AppTrust applications.
+type ApplicationsService interface {
+	Get(ctx context.Context, applicationKey string) (*Application, error)
+	List(ctx context.Context, opts ApplicationListOptions) ([]Application, error)
+	// ListAll pages through all applications matching opts.
+	ListAll(ctx context.Context, opts ApplicationListOptions) ([]Application, error)
+	// ListPages pages through the applications matching opts, passing each page to yield until it
+	// returns false, so callers can stop without reading the remaining pages.
+	ListPages(ctx context.Context, opts ApplicationListOptions, yield func([]Application) bool) error
+	Create(ctx context.Context, application Application) (*Application, error)
+	Update(ctx context.Context, applicationKey string, update ApplicationUpdate) (*Application, error)
+	Delete(ctx context.Context, applicationKey string) error
+}
+
+// Application is the application object used by the create, get and list endpoints.
+type Application struct {
+	ApplicationKey  string            `json:"application_key"`
+	ApplicationName string            `json:"application_name"`
+	ProjectKey      string            `json:"project_key"`
+	Description     string            `json:"description,omitempty"`
+	MaturityLevel   string            `json:"maturity_level,omitempty"` // API uses "maturity_level" consistently for all operations (GET/POST/PATCH)
+	Criticality     string            `json:"criticality,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	UserOwners      []string          `json:"user_owners,omitempty"`
+	GroupOwners     []string          `json:"group_owners,omitempty"`
+
+	// Audit metadata returned by the API. It is never sent, as nil pointers are omitted, and a
+	// field the response leaves out stays nil so it can be told apart from an empty value.
+	Created       *string `json:"created,omitempty"`
+	CreatedBy     *string `json:"created_by,omitempty"`
+	Modified      *string `json:"modified,omitempty"`
+	ModifiedBy    *string `json:"modified_by,omitempty"`
+	VersionsCount *int64  `json:"versions_count,omitempty"`
+	LatestVersion *string `json:"latest_version,omitempty"`
+}
+
+// ApplicationUpdate is the PATCH body. Nil pointers leave a field unchanged; labels and owners
+// are always sent, so an empty map or slice clears them.
+type ApplicationUpdate struct {
+	// ProjectKey is sent as the "project" query parameter for context/authorization purposes.
+	ProjectKey      string            `json:"-"`
+	ApplicationName *string           `json:"application_name,omitempty"`
+	Description     *string           `json:"description,omitempty"`
+	MaturityLevel   *string           `json:"maturity_level,omitempty"`
+	Criticality     *string           `json:"criticality,omitempty"`
+	Labels          map[string]string `json:"labels"`       // No omitempty - empty map must be sent to clear
+	UserOwners      []string          `json:"user_owners"`  // No omitempty - empty array must be sent to clear
+	GroupOwners     []string          `json:"group_owners"` // No omitempty - empty array must be sent to clear
+}
+
+// ApplicationListOptions are the GET /v1/applications filters. Empty values are not sent.
+// The list API uses "maturity" (not "maturity_level") and accepts "owner" and "label" multiple times.
+type ApplicationListOptions struct {
+	ProjectKey  string
+	Name        string
+	Maturity    string
+	Criticality string
+	Owners      []string
+	Labels      []string
+	OrderBy     string
+	OrderAsc    *bool
+	Pagination
+}
+
+func (o ApplicationListOptions) query() url.Values {
+	values := url.Values{}
+	setQueryString(values, "project_key", o.ProjectKey)
+	setQueryString(values, "name", o.Name)
+	setQueryString(values, "maturity", o.Maturity)
+	setQueryString(values, "criticality", o.Criticality)
+	setQueryString(values, "order_by", o.OrderBy)
+	setQueryBool(values, "order_asc", o.OrderAsc)
+	o.Pagination.setQuery(values)
+	for _, owner := range o.Owners {
+		values.Add("owner", owner)
+	}
+	for _, label := range o.Labels {
+		values.Add("label", label)
+	}
+	return values
+}
+
+type applicationsService struct {
+	restyClient *resty.Client
+}
+
+func (s *applicationsService) Get(ctx context.Context, applicationKey string) (*Application, error) {
+	var result Application
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// List returns the applications matching opts. The API returns a plain array without
+// pagination metadata.
+func (s *applicationsService) List(ctx context.Context, opts ApplicationListOptions) ([]Application, error) {
+	var result []Application
+	request := s.restyClient.R().
+		SetQueryParamsFromValues(opts.query()).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationsEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListAll pages through the applications list from opts.Offset (or the start). As the response
+// has no total, an empty page or a page shorter than the page size ends the list.
+func (s *applicationsService) ListAll(ctx context.Context, opts ApplicationListOptions) ([]Application, error) {
+	return listAll(opts.Pagination, s.page(ctx, opts))
+}
+
+func (s *applicationsService) ListPages(ctx context.Context, opts ApplicationListOptions, yield func([]Application) bool) error {
+	return listPages(opts.Pagination, s.page(ctx, opts), yield)
+}
+
+func (s *applicationsService) page(ctx context.Context, opts ApplicationListOptions) func(Pagination) ([]Application, int64, error) {
+	return func(p Pagination) ([]Application, int64, error) {
+		opts.Pagination = p
+		page, err := s.List(ctx, opts)
+		return page, unknownTotal, err
+	}
+}
+
+func (s *applicationsService) Create(ctx context.Context, application Application) (*Application, error) {
+	var result Application
+	request := s.restyClient.R().
+		SetBody(application).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodPost, ApplicationsEndpoint, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *applicationsService) Update(ctx context.Context, applicationKey string, update ApplicationUpdate) (*Application, error) {
+	var result Application
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetBody(update).
+		SetResult(&result)
+	if update.ProjectKey != "" {
+		request.SetQueryParam("project", update.ProjectKey)
+	}
+	if _, err := send(ctx, request, http.MethodPatch, ApplicationEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *applicationsService) Delete(ctx context.Context, applicationKey string) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey)
+	_, err := send(ctx, request, http.MethodDelete, ApplicationEndpoint, http.StatusOK, http.StatusNoContent)
+	return err
+}
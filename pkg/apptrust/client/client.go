@@ -0,0 +1,158 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is a typed client for the JFrog AppTrust REST API. It owns the API endpoints,
+// request and response models, pagination and error decoding, and has no dependency on the
+// Terraform plugin framework so it can be used by other Go tooling.
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Client gives access to the AppTrust API services.
+type Client interface {
+	Applications() ApplicationsService
+	Versions() VersionsService
+	Promotions() PromotionsService
+	Packages() PackagesService
+	Tokens() TokensService
+}
+
+// New returns a Client sending requests with restyClient, which must already have the platform
+// base URL and authentication configured.
+func New(restyClient *resty.Client) Client {
+	return &apptrustClient{
+		applications: &applicationsService{restyClient: restyClient},
+		versions:     &versionsService{restyClient: restyClient},
+		promotions:   &promotionsService{restyClient: restyClient},
+		packages:     &packagesService{restyClient: restyClient},
+		tokens:       &tokensService{restyClient: restyClient},
+	}
+}
+
+type apptrustClient struct {
+	applications *applicationsService
+	versions     *versionsService
+	promotions   *promotionsService
+	packages     *packagesService
+	tokens       *tokensService
+}
+
+func (c *apptrustClient) Applications() ApplicationsService { return c.applications }
+func (c *apptrustClient) Versions() VersionsService         { return c.versions }
+func (c *apptrustClient) Promotions() PromotionsService     { return c.promotions }
+func (c *apptrustClient) Packages() PackagesService         { return c.packages }
+func (c *apptrustClient) Tokens() TokensService             { return c.tokens }
+
+// DefaultPageSize is the page size used by the ListAll and ListPages helpers when the options
+// have no limit.
+const DefaultPageSize = 250
+
+// maxPages bounds the pages read by one listing, so a server that ignores offset and keeps
+// returning full pages cannot make it loop forever.
+const maxPages = 1000
+
+// unknownTotal is returned by a page fetch when the response has no total.
+const unknownTotal = -1
+
+// listPages reads pages of items from opts.Offset (or the start), using opts.Limit (or
+// DefaultPageSize) as the page size, and passes each page to yield. It stops on an empty page,
+// when the offset reaches the total reported by fetch, on a short page when the total is unknown,
+// or when yield returns false. It fails after maxPages pages.
+func listPages[T any](opts Pagination, fetch func(Pagination) ([]T, int64, error), yield func([]T) bool) error {
+	offset, limit := int64(0), int64(DefaultPageSize)
+	if opts.Offset != nil {
+		offset = *opts.Offset
+	}
+	if opts.Limit != nil && *opts.Limit > 0 {
+		limit = *opts.Limit
+	}
+
+	for range maxPages {
+		page, total, err := fetch(Pagination{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return err
+		}
+		if len(page) == 0 || !yield(page) {
+			return nil
+		}
+		offset += int64(len(page))
+		if (total == unknownTotal && int64(len(page)) < limit) || (total != unknownTotal && offset >= total) {
+			return nil
+		}
+	}
+	return fmt.Errorf("listing did not end after %d pages, the server may ignore the offset parameter", maxPages)
+}
+
+// listAll collects all pages read by listPages.
+func listAll[T any](opts Pagination, fetch func(Pagination) ([]T, int64, error)) ([]T, error) {
+	var items []T
+	err := listPages(opts, fetch, func(page []T) bool {
+		items = append(items, page...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Pagination holds the common offset/limit query parameters. Nil fields are not sent, so the
+// API defaults apply.
+type Pagination struct {
+	Offset *int64
+	Limit  *int64
+}
+
+func (p Pagination) setQuery(values url.Values) {
+	if p.Offset != nil {
+		values.Set("offset", strconv.FormatInt(*p.Offset, 10))
+	}
+	if p.Limit != nil {
+		values.Set("limit", strconv.FormatInt(*p.Limit, 10))
+	}
+}
+
+func setQueryString(values url.Values, key, value string) {
+	if value != "" {
+		values.Set(key, value)
+	}
+}
+
+func setQueryBool(values url.Values, key string, value *bool) {
+	if value != nil {
+		values.Set(key, strconv.FormatBool(*value))
+	}
+}
+
+// send executes the request and returns an *APIError when the response status is not one of
+// expectedStatuses. Transport errors are returned as a *RequestError.
+func send(ctx context.Context, request *resty.Request, method, endpoint string, expectedStatuses ...int) (*resty.Response, error) {
+	response, err := request.SetContext(ctx).Execute(method, endpoint)
+	if err != nil {
+		return response, &RequestError{Attempts: request.Attempt, Err: err}
+	}
+	for _, status := range expectedStatuses {
+		if response.StatusCode() == status {
+			return response, nil
+		}
+	}
+	return response, newAPIError(response)
+}
@@ -0,0 +1,390 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest/fakeserver"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+func newTestClient(t *testing.T) client.Client {
+	t.Helper()
+	server := fakeserver.New("aa")
+	t.Cleanup(server.Close)
+	return client.New(resty.New().SetBaseURL(server.URL).SetAuthToken(fakeserver.AccessToken))
+}
+
+func createApplication(t *testing.T, c client.Client, key string) {
+	t.Helper()
+	_, err := c.Applications().Create(context.Background(), client.Application{
+		ApplicationKey:  key,
+		ApplicationName: key,
+		ProjectKey:      "aa",
+	})
+	if err != nil {
+		t.Fatalf("create application %s: %v", key, err)
+	}
+}
+
+func createVersion(t *testing.T, c client.Client, appKey, version string) {
+	t.Helper()
+	err := c.Versions().Create(context.Background(), appKey, client.CreateVersionRequest{
+		Version: version,
+		Sources: client.VersionSources{Artifacts: []client.VersionSourceArtifact{{Path: "generic-repo/readme.md"}}},
+	})
+	if err != nil {
+		t.Fatalf("create version %s: %v", version, err)
+	}
+}
+
+func TestApplications_lifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	created, err := c.Applications().Create(ctx, client.Application{
+		ApplicationKey:  "app-1",
+		ApplicationName: "App 1",
+		ProjectKey:      "aa",
+		Labels:          map[string]string{"team": "qa"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.MaturityLevel != "unspecified" || created.Criticality != "unspecified" {
+		t.Errorf("expected unspecified defaults, got maturity %q criticality %q", created.MaturityLevel, created.Criticality)
+	}
+
+	name := "Renamed"
+	updated, err := c.Applications().Update(ctx, "app-1", client.ApplicationUpdate{
+		ProjectKey:      "aa",
+		ApplicationName: &name,
+		Labels:          map[string]string{},
+		UserOwners:      []string{"alice"},
+		GroupOwners:     []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ApplicationName != name || len(updated.Labels) != 0 || len(updated.UserOwners) != 1 {
+		t.Errorf("unexpected application after update: %+v", updated)
+	}
+
+	apps, err := c.Applications().List(ctx, client.ApplicationListOptions{ProjectKey: "aa", Owners: []string{"alice"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 1 || apps[0].ApplicationKey != "app-1" {
+		t.Errorf("expected app-1 in owner filtered list, got %+v", apps)
+	}
+
+	if err := c.Applications().Delete(ctx, "app-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Applications().Get(ctx, "app-1"); !client.IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestApplications_errors(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	createApplication(t, c, "app-1")
+
+	_, err := c.Applications().Create(ctx, client.Application{ApplicationKey: "app-1", ApplicationName: "dup", ProjectKey: "aa"})
+	if !client.IsConflict(err) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+
+	_, err = c.Applications().Create(ctx, client.Application{ApplicationKey: "app-2", ProjectKey: "aa"})
+	apiErr, ok := client.AsAPIError(err)
+	if !ok || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected bad request, got %v", err)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Field != "application_name" {
+		t.Errorf("expected application_name field error, got %+v", apiErr.Errors)
+	}
+
+	createVersion(t, c, "app-1", "1.0.0")
+	if err := c.Applications().Delete(ctx, "app-1"); !client.IsConflict(err) {
+		t.Errorf("expected conflict deleting application with versions, got %v", err)
+	}
+
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+	unauthenticated := client.New(resty.New().SetBaseURL(server.URL))
+	if _, err := unauthenticated.Applications().Get(ctx, "app-1"); !client.HasStatus(err, http.StatusUnauthorized) {
+		t.Errorf("expected unauthorized, got %v", err)
+	}
+}
+
+func TestApplications_listAll(t *testing.T) {
+	c := newTestClient(t)
+	for i := 0; i < 5; i++ {
+		createApplication(t, c, fmt.Sprintf("app-%d", i))
+	}
+
+	limit := int64(2)
+	apps, err := c.Applications().ListAll(context.Background(), client.ApplicationListOptions{ProjectKey: "aa", Pagination: client.Pagination{Limit: &limit}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apps) != 5 {
+		t.Errorf("expected 5 applications across pages, got %d", len(apps))
+	}
+}
+
+// offsetIgnoringServer returns the same full page for every list request, whatever the offset.
+func offsetIgnoringServer(t *testing.T, body string) (client.Client, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return client.New(resty.New().SetBaseURL(server.URL)), &requests
+}
+
+func TestListAll_serverIgnoresOffset(t *testing.T) {
+	ctx := context.Background()
+	limit := int64(2)
+
+	t.Run("without total", func(t *testing.T) {
+		c, requests := offsetIgnoringServer(t, `[{"application_key":"app-1"},{"application_key":"app-2"}]`)
+		_, err := c.Applications().ListAll(ctx, client.ApplicationListOptions{Pagination: client.Pagination{Limit: &limit}})
+		if err == nil || !strings.Contains(err.Error(), "may ignore the offset parameter") {
+			t.Fatalf("expected the page guard to stop the listing, got %v", err)
+		}
+		if requests.Load() != 1000 {
+			t.Errorf("expected 1000 requests, got %d", requests.Load())
+		}
+	})
+
+	t.Run("with total", func(t *testing.T) {
+		c, requests := offsetIgnoringServer(t, `{"versions":[{"version":"1.0.0"},{"version":"1.0.1"}],"total":5}`)
+		if _, err := c.Versions().ListAll(ctx, "app-1", client.VersionListOptions{Pagination: client.Pagination{Limit: &limit}}); err != nil {
+			t.Fatal(err)
+		}
+		if requests.Load() != 3 {
+			t.Errorf("expected the listing to stop once the offset reached the total, got %d requests", requests.Load())
+		}
+	})
+
+	t.Run("empty page", func(t *testing.T) {
+		c, requests := offsetIgnoringServer(t, `{"versions":[],"total":5}`)
+		if _, err := c.Versions().ListAll(ctx, "app-1", client.VersionListOptions{Pagination: client.Pagination{Limit: &limit}}); err != nil {
+			t.Fatal(err)
+		}
+		if requests.Load() != 1 {
+			t.Errorf("expected the listing to stop on an empty page, got %d requests", requests.Load())
+		}
+	})
+}
+
+func TestVersions_listAllAndFind(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	createApplication(t, c, "app-1")
+	for i := 0; i < 7; i++ {
+		createVersion(t, c, "app-1", fmt.Sprintf("1.0.%d", i))
+	}
+
+	limit := int64(3)
+	versions, err := c.Versions().ListAll(ctx, "app-1", client.VersionListOptions{Pagination: client.Pagination{Limit: &limit}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 7 {
+		t.Fatalf("expected 7 versions across pages, got %d", len(versions))
+	}
+
+	found, err := c.Versions().Find(ctx, "app-1", "1.0.0")
+	if err != nil || found == nil || found.ReleaseStatus != "PRE_RELEASE" {
+		t.Errorf("expected to find pre-release 1.0.0, got %+v (%v)", found, err)
+	}
+	missing, err := c.Versions().Find(ctx, "app-1", "9.9.9")
+	if err != nil || missing != nil {
+		t.Errorf("expected nil for a missing version, got %+v (%v)", missing, err)
+	}
+
+	if err := c.Versions().Update(ctx, "app-1", "1.0.0", client.UpdateVersionRequest{Tag: "tagged"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Versions().Delete(ctx, "app-1", "1.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Versions().Delete(ctx, "app-1", "1.0.1"); !client.IsNotFound(err) {
+		t.Errorf("expected not found deleting twice, got %v", err)
+	}
+}
+
+func TestPromotions_lifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	createApplication(t, c, "app-1")
+	createVersion(t, c, "app-1", "1.0.0")
+
+	if err := c.Promotions().Promote(ctx, "app-1", "1.0.0", client.PromoteRequest{TargetStage: "QA", PromotionType: "copy"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Promotions().Release(ctx, "app-1", "1.0.0", client.ReleaseRequest{PromotionType: "copy"}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := c.Versions().Status(ctx, "app-1", "1.0.0")
+	if err != nil || status.VersionReleaseStatus != "RELEASED" {
+		t.Errorf("expected RELEASED, got %+v (%v)", status, err)
+	}
+
+	if err := c.Promotions().Rollback(ctx, "app-1", "1.0.0", client.RollbackRequest{FromStage: "PROD"}); err != nil {
+		t.Fatal(err)
+	}
+	promotions, err := c.Promotions().List(ctx, "app-1", "1.0.0", client.PromotionListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if promotions.Total != 3 || promotions.Promotions[0].SourceStage != "PROD" || promotions.Promotions[0].TargetStage != "QA" {
+		t.Errorf("expected newest rollback record PROD -> QA of 3, got %+v", promotions)
+	}
+
+	err = c.Promotions().Promote(ctx, "app-1", "1.0.0", client.PromoteRequest{TargetStage: "QA", PromotionType: "teleport"})
+	if !client.HasStatus(err, http.StatusBadRequest) {
+		t.Errorf("expected bad request for an invalid promotion type, got %v", err)
+	}
+	if err := c.Promotions().Promote(ctx, "app-1", "2.0.0", client.PromoteRequest{TargetStage: "QA"}); !client.IsNotFound(err) {
+		t.Errorf("expected not found for a missing version, got %v", err)
+	}
+}
+
+func TestPackages_lifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+	createApplication(t, c, "app-1")
+
+	for _, version := range []string{"1.0.0", "1.1.0"} {
+		err := c.Packages().Bind(ctx, "app-1", client.BindPackageRequest{PackageType: "npm", PackageName: "left-pad", PackageVersion: version})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := c.Packages().Bind(ctx, "app-1", client.BindPackageRequest{PackageType: "npm", PackageName: "left-pad", PackageVersion: "1.0.0"})
+	if !client.IsConflict(err) {
+		t.Errorf("expected conflict binding twice, got %v", err)
+	}
+
+	packages, err := c.Packages().List(ctx, "app-1", client.PackageListOptions{Type: "npm"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packages.Packages) != 1 || packages.Packages[0].NumVersions != 2 || packages.Packages[0].LatestVersion != "1.1.0" {
+		t.Errorf("unexpected packages: %+v", packages.Packages)
+	}
+
+	versions, err := c.Packages().ListVersions(ctx, "app-1", "npm", "left-pad", client.PackageVersionListOptions{PackageVersion: "1.1.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versions.Total != 1 || versions.Versions[0].Version != "1.1.0" {
+		t.Errorf("unexpected package versions: %+v", versions)
+	}
+
+	limit := int64(1)
+	all, err := c.Packages().ListAllVersions(ctx, "app-1", "npm", "left-pad", client.PackageVersionListOptions{Pagination: client.Pagination{Limit: &limit}})
+	if err != nil || len(all) != 2 {
+		t.Errorf("expected 2 versions across pages, got %+v (%v)", all, err)
+	}
+	if err := c.Packages().Bind(ctx, "app-1", client.BindPackageRequest{PackageType: "docker", PackageName: "web", PackageVersion: "1.0.0"}); err != nil {
+		t.Fatal(err)
+	}
+	allPackages, err := c.Packages().ListAll(ctx, "app-1", client.PackageListOptions{Pagination: client.Pagination{Limit: &limit}})
+	if err != nil || len(allPackages) != 2 {
+		t.Errorf("expected 2 packages across pages, got %+v (%v)", allPackages, err)
+	}
+
+	if err := c.Packages().Unbind(ctx, "app-1", "npm", "left-pad", "1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Packages().Unbind(ctx, "app-1", "npm", "left-pad", "1.0.0"); !client.IsNotFound(err) {
+		t.Errorf("expected not found unbinding twice, got %v", err)
+	}
+}
+
+func TestTokens_lifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	token, err := c.Tokens().Create(ctx, client.CreateTokenRequest{
+		Scope:      "applied-permissions/roles:aa:Developer",
+		ProjectKey: "aa",
+		ExpiresIn:  600,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.TokenID == "" || token.AccessToken == "" || token.ExpiresIn != 600 {
+		t.Errorf("unexpected token: %+v", token)
+	}
+
+	_, err = c.Tokens().Create(ctx, client.CreateTokenRequest{Scope: "applied-permissions/roles:zz:Developer", ProjectKey: "zz"})
+	if !client.IsNotFound(err) {
+		t.Errorf("expected not found for an unknown project, got %v", err)
+	}
+
+	if err := c.Tokens().Revoke(ctx, token.TokenID); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Tokens().Revoke(ctx, token.TokenID); !client.IsNotFound(err) {
+		t.Errorf("expected not found revoking twice, got %v", err)
+	}
+}
+
+func TestSend_transportErrorAttempts(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		_ = conn.Close()
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.New(resty.New().SetBaseURL(server.URL).SetRetryCount(2).SetRetryWaitTime(time.Millisecond))
+	_, err := c.Applications().Get(context.Background(), "app-1")
+
+	var requestErr *client.RequestError
+	if !errors.As(err, &requestErr) || requestErr.Attempts != 3 || requests.Load() != 3 {
+		t.Fatalf("expected a request error after 3 attempts, got %v after %d requests", err, requests.Load())
+	}
+	if !strings.Contains(err.Error(), "the request failed after 3 attempts") {
+		t.Errorf("expected the number of attempts in %q", err.Error())
+	}
+	if _, ok := client.AsAPIError(err); ok {
+		t.Error("expected a transport error not to be an API error")
+	}
+}
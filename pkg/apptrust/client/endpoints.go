@@ -0,0 +1,38 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// AppTrust API endpoints, relative to the platform URL. Path parameters are filled in with
+// resty SetPathParam.
+const (
+	ApplicationsEndpoint = "apptrust/api/v1/applications"
+	ApplicationEndpoint  = ApplicationsEndpoint + "/{application_key}"
+
+	ApplicationVersionsEndpoint    = ApplicationEndpoint + "/versions"
+	ApplicationVersionEndpoint     = ApplicationVersionsEndpoint + "/{version}"
+	ApplicationVersionPromoteEP    = ApplicationVersionEndpoint + "/promote"
+	ApplicationVersionReleaseEP    = ApplicationVersionEndpoint + "/release"
+	ApplicationVersionRollbackEP   = ApplicationVersionEndpoint + "/rollback"
+	ApplicationVersionStatusEP     = ApplicationVersionEndpoint + "/status"
+	ApplicationVersionPromotionsEP = ApplicationVersionEndpoint + "/promotions"
+
+	ApplicationPackagesEndpoint        = ApplicationEndpoint + "/packages"
+	ApplicationPackageVersionsEndpoint = ApplicationPackagesEndpoint + "/{type}/{name}"
+	ApplicationPackageVersionEndpoint  = ApplicationPackagesEndpoint + "/{type}/{name}/{version}"
+
+	// Access API endpoints used to mint short-lived tokens.
+	AccessTokensEndpoint = "access/api/v1/tokens"
+	AccessTokenEndpoint  = AccessTokensEndpoint + "/{token_id}"
+)
@@ -0,0 +1,133 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/samber/lo"
+)
+
+// Error is a single entry of the AppTrust `errors` response array.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field"`
+}
+
+func (e Error) String() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s (%s)", e.Field, e.Message, e.Code)
+	}
+	if e.Code != "" {
+		return fmt.Sprintf("%s - %s", e.Code, e.Message)
+	}
+	return e.Message
+}
+
+// ErrorsResponse is the error body returned by the AppTrust API.
+type ErrorsResponse struct {
+	Errors []Error `json:"errors"`
+}
+
+func (r ErrorsResponse) String() string {
+	errs := lo.Reduce(r.Errors, func(err string, item Error, _ int) string {
+		if err == "" {
+			return item.String()
+		} else {
+			return fmt.Sprintf("%s, %s", err, item.String())
+		}
+	}, "")
+	return errs
+}
+
+// APIError is returned when the API answers with an unexpected status code.
+type APIError struct {
+	// Response is the raw response, kept for callers that render their own error messages.
+	Response   *resty.Response
+	StatusCode int
+	// Errors holds the decoded `errors` array, if the body had one.
+	Errors []Error
+}
+
+func newAPIError(response *resty.Response) *APIError {
+	apiErr := &APIError{
+		Response:   response,
+		StatusCode: response.StatusCode(),
+	}
+	var body ErrorsResponse
+	if err := json.Unmarshal(response.Body(), &body); err == nil {
+		apiErr.Errors = body.Errors
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("AppTrust API error (status %d): %s", e.StatusCode, ErrorsResponse{Errors: e.Errors}.String())
+	}
+	if e.Response != nil && len(e.Response.Body()) > 0 {
+		return fmt.Sprintf("AppTrust API error (status %d): %s", e.StatusCode, e.Response.String())
+	}
+	return fmt.Sprintf("AppTrust API error (status %d)", e.StatusCode)
+}
+
+// RequestError is returned when a request got no response, such as on a network error. It
+// records how many times the request was sent, as retryable requests are sent again by the
+// provider retry policy before giving up.
+type RequestError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RequestError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("%s (the request failed after %d attempts)", e.Err, e.Attempts)
+	}
+	return e.Err.Error()
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// AsAPIError returns the *APIError wrapped in err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err is an *APIError with status 404.
+func IsNotFound(err error) bool {
+	return HasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an *APIError with status 409.
+func IsConflict(err error) bool {
+	return HasStatus(err, http.StatusConflict)
+}
+
+// HasStatus reports whether err is an *APIError with the given status code.
+func HasStatus(err error, statusCode int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == statusCode
+}
@@ -0,0 +1,199 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// PackagesService manages the package versions bound to an application.
+type PackagesService interface {
+	Bind(ctx context.Context, applicationKey string, request BindPackageRequest) error
+	Unbind(ctx context.Context, applicationKey, packageType, packageName, packageVersion string) error
+	List(ctx context.Context, applicationKey string, opts PackageListOptions) (*PackageList, error)
+	ListVersions(ctx context.Context, applicationKey, packageType, packageName string, opts PackageVersionListOptions) (*PackageVersionList, error)
+	// ListAll pages through all packages bound to the application matching opts.
+	ListAll(ctx context.Context, applicationKey string, opts PackageListOptions) ([]Package, error)
+	// ListPages pages through the packages matching opts, passing each page to yield until it
+	// returns false.
+	ListPages(ctx context.Context, applicationKey string, opts PackageListOptions, yield func([]Package) bool) error
+	// ListAllVersions pages through all bound versions of a package.
+	ListAllVersions(ctx context.Context, applicationKey, packageType, packageName string, opts PackageVersionListOptions) ([]PackageVersion, error)
+}
+
+// BindPackageRequest is the body of the bind package endpoint.
+type BindPackageRequest struct {
+	PackageType    string `json:"package_type"`
+	PackageName    string `json:"package_name"`
+	PackageVersion string `json:"package_version"`
+}
+
+// Package is a package bound to an application.
+type Package struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	NumVersions   int    `json:"num_versions"`
+	LatestVersion string `json:"latest_version"`
+}
+
+// PackagePagination is the pagination block of the packages list.
+type PackagePagination struct {
+	Offset     int `json:"offset"`
+	Limit      int `json:"limit"`
+	TotalItems int `json:"total_items"`
+}
+
+// PackageList is a page of bound packages.
+type PackageList struct {
+	Packages   []Package          `json:"packages"`
+	Pagination *PackagePagination `json:"pagination,omitempty"`
+}
+
+// PackageListOptions are the packages list filters.
+type PackageListOptions struct {
+	Name string
+	Type string
+	Pagination
+}
+
+func (o PackageListOptions) query() url.Values {
+	values := url.Values{}
+	setQueryString(values, "name", o.Name)
+	setQueryString(values, "type", o.Type)
+	o.Pagination.setQuery(values)
+	return values
+}
+
+// PackageVersion is a bound version of a package.
+type PackageVersion struct {
+	Version     string `json:"version"`
+	VcsURL      string `json:"vcs_url"`
+	VcsBranch   string `json:"vcs_branch"`
+	VcsRevision string `json:"vcs_revision"`
+	// Branch and Revision are returned instead of VcsBranch and VcsRevision by some platform versions.
+	Branch   string `json:"branch"`
+	Revision string `json:"revision"`
+}
+
+// PackageVersionList is a page of bound package versions.
+type PackageVersionList struct {
+	Versions []PackageVersion `json:"versions"`
+	Total    int              `json:"total"`
+	Offset   int              `json:"offset"`
+	Limit    int              `json:"limit"`
+}
+
+// PackageVersionListOptions are the bound package versions filters.
+type PackageVersionListOptions struct {
+	PackageVersion string
+	Pagination
+}
+
+func (o PackageVersionListOptions) query() url.Values {
+	values := url.Values{}
+	setQueryString(values, "package_version", o.PackageVersion)
+	o.Pagination.setQuery(values)
+	return values
+}
+
+type packagesService struct {
+	restyClient *resty.Client
+}
+
+func (s *packagesService) Bind(ctx context.Context, applicationKey string, body BindPackageRequest) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetBody(body)
+	_, err := send(ctx, request, http.MethodPost, ApplicationPackagesEndpoint, http.StatusCreated)
+	return err
+}
+
+func (s *packagesService) Unbind(ctx context.Context, applicationKey, packageType, packageName, packageVersion string) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("type", packageType).
+		SetPathParam("name", packageName).
+		SetPathParam("version", packageVersion)
+	_, err := send(ctx, request, http.MethodDelete, ApplicationPackageVersionEndpoint, http.StatusOK, http.StatusNoContent)
+	return err
+}
+
+func (s *packagesService) List(ctx context.Context, applicationKey string, opts PackageListOptions) (*PackageList, error) {
+	var result PackageList
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetQueryParamsFromValues(opts.query()).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationPackagesEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *packagesService) ListVersions(ctx context.Context, applicationKey, packageType, packageName string, opts PackageVersionListOptions) (*PackageVersionList, error) {
+	var result PackageVersionList
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("type", packageType).
+		SetPathParam("name", packageName).
+		SetQueryParamsFromValues(opts.query()).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationPackageVersionsEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAll pages through the packages list from opts.Offset (or the start) until all packages
+// were read. opts.Limit is used as the page size.
+func (s *packagesService) ListAll(ctx context.Context, applicationKey string, opts PackageListOptions) ([]Package, error) {
+	return listAll(opts.Pagination, s.page(ctx, applicationKey, opts))
+}
+
+func (s *packagesService) ListPages(ctx context.Context, applicationKey string, opts PackageListOptions, yield func([]Package) bool) error {
+	return listPages(opts.Pagination, s.page(ctx, applicationKey, opts), yield)
+}
+
+func (s *packagesService) page(ctx context.Context, applicationKey string, opts PackageListOptions) func(Pagination) ([]Package, int64, error) {
+	return func(p Pagination) ([]Package, int64, error) {
+		opts.Pagination = p
+		page, err := s.List(ctx, applicationKey, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		total := int64(unknownTotal)
+		if page.Pagination != nil {
+			total = int64(page.Pagination.TotalItems)
+		}
+		return page.Packages, total, nil
+	}
+}
+
+// ListAllVersions pages through the bound versions of a package from opts.Offset (or the start)
+// until all versions were read. opts.Limit is used as the page size.
+func (s *packagesService) ListAllVersions(ctx context.Context, applicationKey, packageType, packageName string, opts PackageVersionListOptions) ([]PackageVersion, error) {
+	return listAll(opts.Pagination, func(p Pagination) ([]PackageVersion, int64, error) {
+		opts.Pagination = p
+		page, err := s.ListVersions(ctx, applicationKey, packageType, packageName, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Versions, int64(page.Total), nil
+	})
+}
@@ -0,0 +1,144 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// PromotionsService promotes, releases and rolls back application versions.
+type PromotionsService interface {
+	Promote(ctx context.Context, applicationKey, version string, request PromoteRequest) error
+	Release(ctx context.Context, applicationKey, version string, request ReleaseRequest) error
+	Rollback(ctx context.Context, applicationKey, version string, request RollbackRequest) error
+	List(ctx context.Context, applicationKey, version string, opts PromotionListOptions) (*PromotionList, error)
+}
+
+// ArtifactAdditionalProperty is a property added to the promoted artifacts.
+type ArtifactAdditionalProperty struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// PromoteRequest is the request.PromoteAppVersionRequest body.
+type PromoteRequest struct {
+	TargetStage                  string                       `json:"target_stage"`
+	PromotionType                string                       `json:"promotion_type,omitempty"`
+	IncludedRepositoryKeys       []string                     `json:"included_repository_keys,omitempty"`
+	ExcludedRepositoryKeys       []string                     `json:"excluded_repository_keys,omitempty"`
+	ArtifactAdditionalProperties []ArtifactAdditionalProperty `json:"artifact_additional_properties,omitempty"`
+	PromotionAuthorizationType   string                       `json:"promotion_authorization_type,omitempty"`
+}
+
+// ReleaseRequest is the body of the release endpoint.
+type ReleaseRequest struct {
+	PromotionType              string   `json:"promotion_type,omitempty"`
+	IncludedRepositoryKeys     []string `json:"included_repository_keys,omitempty"`
+	ExcludedRepositoryKeys     []string `json:"excluded_repository_keys,omitempty"`
+	PromotionAuthorizationType string   `json:"promotion_authorization_type,omitempty"`
+}
+
+// RollbackRequest is the body of the rollback endpoint.
+type RollbackRequest struct {
+	FromStage string `json:"from_stage"`
+}
+
+// PromotionMessage is a message attached to a promotion record.
+type PromotionMessage struct {
+	Text string `json:"text"`
+}
+
+// Promotion is a promotion record of an application version.
+type Promotion struct {
+	ApplicationKey     string             `json:"application_key"`
+	ApplicationVersion string             `json:"application_version"`
+	Created            string             `json:"created"`
+	CreatedBy          string             `json:"created_by"`
+	CreatedMillis      int64              `json:"created_millis"`
+	Messages           []PromotionMessage `json:"messages"`
+	ProjectKey         string             `json:"project_key"`
+	SourceStage        string             `json:"source_stage"`
+	Status             string             `json:"status"`
+	TargetStage        string             `json:"target_stage"`
+}
+
+// PromotionList is a page of promotion records.
+type PromotionList struct {
+	Promotions []Promotion `json:"promotions"`
+	Total      int         `json:"total"`
+	Limit      int         `json:"limit"`
+	Offset     int         `json:"offset"`
+}
+
+// PromotionListOptions are the promotions list query parameters.
+type PromotionListOptions struct {
+	Include  string
+	FilterBy string
+	OrderBy  string
+	OrderAsc *bool
+	Pagination
+}
+
+func (o PromotionListOptions) query() url.Values {
+	values := url.Values{}
+	setQueryString(values, "include", o.Include)
+	setQueryString(values, "filter_by", o.FilterBy)
+	setQueryString(values, "order_by", o.OrderBy)
+	setQueryBool(values, "order_asc", o.OrderAsc)
+	o.Pagination.setQuery(values)
+	return values
+}
+
+type promotionsService struct {
+	restyClient *resty.Client
+}
+
+func (s *promotionsService) post(ctx context.Context, endpoint, applicationKey, version string, body interface{}) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("version", version).
+		SetBody(body)
+	_, err := send(ctx, request, http.MethodPost, endpoint, http.StatusOK, http.StatusAccepted)
+	return err
+}
+
+func (s *promotionsService) Promote(ctx context.Context, applicationKey, version string, body PromoteRequest) error {
+	return s.post(ctx, ApplicationVersionPromoteEP, applicationKey, version, body)
+}
+
+func (s *promotionsService) Release(ctx context.Context, applicationKey, version string, body ReleaseRequest) error {
+	return s.post(ctx, ApplicationVersionReleaseEP, applicationKey, version, body)
+}
+
+func (s *promotionsService) Rollback(ctx context.Context, applicationKey, version string, body RollbackRequest) error {
+	return s.post(ctx, ApplicationVersionRollbackEP, applicationKey, version, body)
+}
+
+func (s *promotionsService) List(ctx context.Context, applicationKey, version string, opts PromotionListOptions) (*PromotionList, error) {
+	var result PromotionList
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("version", version).
+		SetQueryParamsFromValues(opts.query()).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationVersionPromotionsEP, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
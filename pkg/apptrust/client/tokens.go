@@ -0,0 +1,69 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// TokensService creates and revokes access tokens with the JFrog Access API.
+type TokensService interface {
+	Create(ctx context.Context, token CreateTokenRequest) (*Token, error)
+	Revoke(ctx context.Context, tokenID string) error
+}
+
+// CreateTokenRequest is the POST /access/api/v1/tokens body.
+type CreateTokenRequest struct {
+	// Scope is e.g. "applied-permissions/roles:<project_key>:<role>" for a project-scoped token.
+	Scope       string `json:"scope"`
+	ProjectKey  string `json:"project_key,omitempty"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Description string `json:"description,omitempty"`
+	Refreshable bool   `json:"refreshable"`
+}
+
+// Token is the POST /access/api/v1/tokens response.
+type Token struct {
+	TokenID     string `json:"token_id"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+}
+
+type tokensService struct {
+	restyClient *resty.Client
+}
+
+func (s *tokensService) Create(ctx context.Context, token CreateTokenRequest) (*Token, error) {
+	var result Token
+	request := s.restyClient.R().
+		SetBody(token).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodPost, AccessTokensEndpoint, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *tokensService) Revoke(ctx context.Context, tokenID string) error {
+	request := s.restyClient.R().
+		SetPathParam("token_id", tokenID)
+	_, err := send(ctx, request, http.MethodDelete, AccessTokenEndpoint, http.StatusOK, http.StatusNoContent)
+	return err
+}
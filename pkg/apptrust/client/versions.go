@@ -0,0 +1,210 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// VersionsService manages application versions.
+type VersionsService interface {
+	List(ctx context.Context, applicationKey string, opts VersionListOptions) (*VersionList, error)
+	ListAll(ctx context.Context, applicationKey string, opts VersionListOptions) ([]Version, error)
+	// ListPages pages through the versions matching opts, passing each page to yield until it
+	// returns false.
+	ListPages(ctx context.Context, applicationKey string, opts VersionListOptions, yield func([]Version) bool) error
+	// Find returns the version, or nil without error when the application has no such version.
+	Find(ctx context.Context, applicationKey, version string) (*Version, error)
+	Create(ctx context.Context, applicationKey string, request CreateVersionRequest) error
+	Update(ctx context.Context, applicationKey, version string, request UpdateVersionRequest) error
+	Delete(ctx context.Context, applicationKey, version string) error
+	Status(ctx context.Context, applicationKey, version string) (*VersionStatus, error)
+}
+
+// Version is an entry of the versions list.
+type Version struct {
+	Version       string `json:"version"`
+	Tag           string `json:"tag"`
+	Status        string `json:"status"`
+	ReleaseStatus string `json:"release_status"`
+	CurrentStage  string `json:"current_stage"`
+	CreatedBy     string `json:"created_by"`
+	Created       string `json:"created"`
+}
+
+// VersionList is a page of versions.
+type VersionList struct {
+	Versions []Version `json:"versions"`
+	Total    int       `json:"total"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
+
+// VersionListOptions are the GET /v1/applications/{application_key}/versions filters.
+type VersionListOptions struct {
+	CreatedBy     string
+	ReleaseStatus string
+	Tag           string
+	OrderAsc      *bool
+	Pagination
+}
+
+func (o VersionListOptions) query() url.Values {
+	values := url.Values{}
+	setQueryString(values, "created_by", o.CreatedBy)
+	setQueryString(values, "release_status", o.ReleaseStatus)
+	setQueryString(values, "tag", o.Tag)
+	setQueryBool(values, "order_asc", o.OrderAsc)
+	o.Pagination.setQuery(values)
+	return values
+}
+
+// VersionSourceArtifact is an artifact source of a new version.
+type VersionSourceArtifact struct {
+	Path   string `json:"path"`
+	Sha256 string `json:"sha256,omitempty"`
+}
+
+// VersionSourceBuild is a build source of a new version.
+type VersionSourceBuild struct {
+	Name                string `json:"name"`
+	Number              string `json:"number"`
+	IncludeDependencies bool   `json:"include_dependencies,omitempty"`
+	RepositoryKey       string `json:"repository_key,omitempty"`
+	Started             string `json:"started,omitempty"`
+}
+
+// VersionSourceVersion is another application version used as a source (CreateAppVersionVersionsSources).
+type VersionSourceVersion struct {
+	ApplicationKey string `json:"application_key"`
+	Version        string `json:"version"`
+}
+
+// VersionSources lists the sources of a new version. At least one is required.
+type VersionSources struct {
+	Artifacts []VersionSourceArtifact `json:"artifacts,omitempty"`
+	Builds    []VersionSourceBuild    `json:"builds,omitempty"`
+	Versions  []VersionSourceVersion  `json:"versions,omitempty"`
+}
+
+// CreateVersionRequest is the body of POST /v1/applications/{application_key}/versions.
+type CreateVersionRequest struct {
+	Version string         `json:"version"`
+	Sources VersionSources `json:"sources"`
+	Tag     string         `json:"tag,omitempty"`
+}
+
+// UpdateVersionRequest is the UpdateAppVersionRequest body. The tag is always sent.
+type UpdateVersionRequest struct {
+	Tag              string              `json:"tag"`
+	Properties       map[string][]string `json:"properties,omitempty"`
+	DeleteProperties []string            `json:"delete_properties,omitempty"`
+}
+
+// VersionStatus is the response of the version status endpoint.
+type VersionStatus struct {
+	VersionReleaseStatus string `json:"version_release_status"`
+}
+
+type versionsService struct {
+	restyClient *resty.Client
+}
+
+func (s *versionsService) List(ctx context.Context, applicationKey string, opts VersionListOptions) (*VersionList, error) {
+	var result VersionList
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetQueryParamsFromValues(opts.query()).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationVersionsEndpoint, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAll pages through the versions list from opts.Offset (or the start) until all versions
+// were read. opts.Limit is used as the page size.
+func (s *versionsService) ListAll(ctx context.Context, applicationKey string, opts VersionListOptions) ([]Version, error) {
+	return listAll(opts.Pagination, s.page(ctx, applicationKey, opts))
+}
+
+func (s *versionsService) ListPages(ctx context.Context, applicationKey string, opts VersionListOptions, yield func([]Version) bool) error {
+	return listPages(opts.Pagination, s.page(ctx, applicationKey, opts), yield)
+}
+
+func (s *versionsService) page(ctx context.Context, applicationKey string, opts VersionListOptions) func(Pagination) ([]Version, int64, error) {
+	return func(p Pagination) ([]Version, int64, error) {
+		opts.Pagination = p
+		page, err := s.List(ctx, applicationKey, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Versions, int64(page.Total), nil
+	}
+}
+
+func (s *versionsService) Find(ctx context.Context, applicationKey, version string) (*Version, error) {
+	versions, err := s.ListAll(ctx, applicationKey, VersionListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *versionsService) Create(ctx context.Context, applicationKey string, body CreateVersionRequest) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetBody(body)
+	_, err := send(ctx, request, http.MethodPost, ApplicationVersionsEndpoint, http.StatusCreated, http.StatusAccepted)
+	return err
+}
+
+func (s *versionsService) Update(ctx context.Context, applicationKey, version string, body UpdateVersionRequest) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("version", version).
+		SetBody(body)
+	_, err := send(ctx, request, http.MethodPatch, ApplicationVersionEndpoint, http.StatusOK, http.StatusAccepted)
+	return err
+}
+
+func (s *versionsService) Delete(ctx context.Context, applicationKey, version string) error {
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("version", version)
+	_, err := send(ctx, request, http.MethodDelete, ApplicationVersionEndpoint, http.StatusOK, http.StatusAccepted, http.StatusNoContent)
+	return err
+}
+
+func (s *versionsService) Status(ctx context.Context, applicationKey, version string) (*VersionStatus, error) {
+	var result VersionStatus
+	request := s.restyClient.R().
+		SetPathParam("application_key", applicationKey).
+		SetPathParam("version", version).
+		SetResult(&result)
+	if _, err := send(ctx, request, http.MethodGet, ApplicationVersionStatusEP, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -17,17 +17,13 @@ package datasource
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/jfrog/terraform-provider-shared/util"
-)
-
-const (
-	applicationEndpoint = "apptrust/api/v1/applications"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationDataSource{}
@@ -37,7 +33,8 @@ func NewApplicationDataSource() datasource.DataSource {
 }
 
 type ApplicationDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationDataSourceModel struct {
@@ -48,20 +45,14 @@ type ApplicationDataSourceModel struct {
 	MaturityLevel   types.String `tfsdk:"maturity_level"`
 	Criticality     types.String `tfsdk:"criticality"`
 	Labels          types.Map    `tfsdk:"labels"`
-	UserOwners      types.List   `tfsdk:"user_owners"`
-	GroupOwners     types.List   `tfsdk:"group_owners"`
-}
-
-type ApplicationAPIModel struct {
-	ApplicationKey  string            `json:"application_key"`
-	ApplicationName string            `json:"application_name"`
-	ProjectKey      string            `json:"project_key"`
-	Description     string            `json:"description,omitempty"`
-	MaturityLevel   string            `json:"maturity_level,omitempty"` // API uses "maturity_level" consistently for all operations (GET/POST/PATCH)
-	Criticality     string            `json:"criticality,omitempty"`
-	Labels          map[string]string `json:"labels,omitempty"`
-	UserOwners      []string          `json:"user_owners,omitempty"`
-	GroupOwners     []string          `json:"group_owners,omitempty"`
+	UserOwners      types.Set    `tfsdk:"user_owners"`
+	GroupOwners     types.Set    `tfsdk:"group_owners"`
+	Created         types.String `tfsdk:"created"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	Modified        types.String `tfsdk:"modified"`
+	ModifiedBy      types.String `tfsdk:"modified_by"`
+	VersionsCount   types.Int64  `tfsdk:"versions_count"`
+	LatestVersion   types.String `tfsdk:"latest_version"`
 }
 
 func (d *ApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -101,16 +92,40 @@ func (d *ApplicationDataSource) Schema(ctx context.Context, req datasource.Schem
 				ElementType: types.StringType,
 				Computed:    true,
 			},
-			"user_owners": schema.ListAttribute{
-				Description: "List of users who own the application.",
+			"user_owners": schema.SetAttribute{
+				Description: "Set of users who own the application.",
 				ElementType: types.StringType,
 				Computed:    true,
 			},
-			"group_owners": schema.ListAttribute{
-				Description: "List of user groups who own the application.",
+			"group_owners": schema.SetAttribute{
+				Description: "Set of user groups who own the application.",
 				ElementType: types.StringType,
 				Computed:    true,
 			},
+			"created": schema.StringAttribute{
+				Description: "The time the application was created.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The user who created the application.",
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "The time the application was last modified.",
+				Computed:    true,
+			},
+			"modified_by": schema.StringAttribute{
+				Description: "The user who last modified the application.",
+				Computed:    true,
+			},
+			"versions_count": schema.Int64Attribute{
+				Description: "The number of versions of the application.",
+				Computed:    true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "The latest version of the application.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -119,7 +134,8 @@ func (d *ApplicationDataSource) Configure(ctx context.Context, req datasource.Co
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -130,41 +146,20 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var result ApplicationAPIModel
-	response, err := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", data.ApplicationKey.ValueString()).
-		SetResult(&result).
-		Get(applicationEndpoint + "/{application_key}")
-
+	result, err := d.client.Applications().Get(ctx, data.ApplicationKey.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Data Source",
-			"An unexpected error occurred while fetching the data source. "+
-				"Please report this issue to the provider developers.\n\n"+
-				"Error: "+err.Error(),
-		)
-		return
-	}
-
-	if response.StatusCode() != http.StatusOK {
-		if response.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Application Not Found",
 				fmt.Sprintf("Application with key '%s' was not found.", data.ApplicationKey.ValueString()),
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Unable to Read Data Source",
-			"An unexpected error occurred while fetching the data source. "+
-				"Please report this issue to the provider developers.\n\n"+
-				"Error: "+response.String(),
-		)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application")...)
 		return
 	}
 
-	diags := data.FromAPIModel(ctx, result)
+	diags := data.FromAPIModel(ctx, *result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -173,7 +168,7 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *ApplicationDataSourceModel) FromAPIModel(ctx context.Context, api ApplicationAPIModel) diag.Diagnostics {
+func (m *ApplicationDataSourceModel) FromAPIModel(ctx context.Context, api client.Application) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	m.ApplicationKey = types.StringValue(api.ApplicationKey)
@@ -186,6 +181,13 @@ func (m *ApplicationDataSourceModel) FromAPIModel(ctx context.Context, api Appli
 		m.Description = types.StringNull()
 	}
 
+	m.Created = types.StringPointerValue(api.Created)
+	m.CreatedBy = types.StringPointerValue(api.CreatedBy)
+	m.Modified = types.StringPointerValue(api.Modified)
+	m.ModifiedBy = types.StringPointerValue(api.ModifiedBy)
+	m.VersionsCount = types.Int64PointerValue(api.VersionsCount)
+	m.LatestVersion = types.StringPointerValue(api.LatestVersion)
+
 	// Treat "unspecified" as null since it's the default value when not explicitly set
 	if api.MaturityLevel != "" && api.MaturityLevel != "unspecified" {
 		m.MaturityLevel = types.StringValue(api.MaturityLevel)
@@ -219,13 +221,13 @@ func (m *ApplicationDataSourceModel) FromAPIModel(ctx context.Context, api Appli
 		for i, v := range api.UserOwners {
 			userOwners[i] = types.StringValue(v)
 		}
-		userOwnersList, d := types.ListValueFrom(ctx, types.StringType, userOwners)
+		userOwnersSet, d := types.SetValueFrom(ctx, types.StringType, userOwners)
 		diags.Append(d...)
 		if !diags.HasError() {
-			m.UserOwners = userOwnersList
+			m.UserOwners = userOwnersSet
 		}
 	} else {
-		m.UserOwners = types.ListNull(types.StringType)
+		m.UserOwners = types.SetNull(types.StringType)
 	}
 
 	if len(api.GroupOwners) > 0 {
@@ -233,13 +235,13 @@ func (m *ApplicationDataSourceModel) FromAPIModel(ctx context.Context, api Appli
 		for i, v := range api.GroupOwners {
 			groupOwners[i] = types.StringValue(v)
 		}
-		groupOwnersList, d := types.ListValueFrom(ctx, types.StringType, groupOwners)
+		groupOwnersSet, d := types.SetValueFrom(ctx, types.StringType, groupOwners)
 		diags.Append(d...)
 		if !diags.HasError() {
-			m.GroupOwners = groupOwnersList
+			m.GroupOwners = groupOwnersSet
 		}
 	} else {
-		m.GroupOwners = types.ListNull(types.StringType)
+		m.GroupOwners = types.SetNull(types.StringType)
 	}
 
 	return diags
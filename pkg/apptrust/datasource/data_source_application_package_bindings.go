@@ -16,8 +16,6 @@ package datasource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,8 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationPackageBindingsDataSource{}
@@ -37,7 +34,8 @@ func NewApplicationPackageBindingsDataSource() datasource.DataSource {
 }
 
 type ApplicationPackageBindingsDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationPackageBindingsDataSourceModel struct {
@@ -50,22 +48,6 @@ type ApplicationPackageBindingsDataSourceModel struct {
 	Pagination     types.Object `tfsdk:"pagination"`
 }
 
-type packageBindingAPIModel struct {
-	Name          string `json:"name"`
-	Type          string `json:"type"`
-	NumVersions   int    `json:"num_versions"`
-	LatestVersion string `json:"latest_version"`
-}
-
-type packageBindingsResponseAPIModel struct {
-	Packages   []packageBindingAPIModel `json:"packages"`
-	Pagination *struct {
-		Offset     int `json:"offset"`
-		Limit      int `json:"limit"`
-		TotalItems int `json:"total_items"`
-	} `json:"pagination,omitempty"`
-}
-
 var packageBindingAttrType = map[string]attr.Type{
 	"name":           types.StringType,
 	"type":           types.StringType,
@@ -136,7 +118,8 @@ func (d *ApplicationPackageBindingsDataSource) Configure(ctx context.Context, re
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationPackageBindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -149,42 +132,26 @@ func (d *ApplicationPackageBindingsDataSource) Read(ctx context.Context, req dat
 	applicationKey := data.ApplicationKey.ValueString()
 	tflog.Info(ctx, "Reading application package bindings", map[string]interface{}{"application_key": applicationKey})
 
-	request := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey)
-	if !data.Name.IsNull() {
-		request = request.SetQueryParam("name", data.Name.ValueString())
-	}
-	if !data.Type.IsNull() {
-		request = request.SetQueryParam("type", data.Type.ValueString())
-	}
-	if !data.Offset.IsNull() {
-		request = request.SetQueryParam("offset", fmt.Sprintf("%d", data.Offset.ValueInt64()))
-	}
-	if !data.Limit.IsNull() {
-		request = request.SetQueryParam("limit", fmt.Sprintf("%d", data.Limit.ValueInt64()))
-	}
-
-	var apiResp packageBindingsResponseAPIModel
-	httpResponse, err := request.SetResult(&apiResp).Get(resource.ApplicationPackagesEndpoint)
+	apiResp, err := d.client.Packages().List(ctx, applicationKey, client.PackageListOptions{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+		Pagination: client.Pagination{
+			Offset: data.Offset.ValueInt64Pointer(),
+			Limit:  data.Limit.ValueInt64Pointer(),
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data Source", "Error: "+err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			data.Packages = types.ListNull(types.ObjectType{AttrTypes: packageBindingAttrType})
 			data.Pagination = types.ObjectNull(paginationAttrType)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		diags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "application package bindings")
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application package bindings")...)
 		return
 	}
 
-	diags := data.fromAPIModel(ctx, apiResp)
+	diags := data.fromAPIModel(ctx, *apiResp)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -192,7 +159,7 @@ func (d *ApplicationPackageBindingsDataSource) Read(ctx context.Context, req dat
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *ApplicationPackageBindingsDataSourceModel) fromAPIModel(ctx context.Context, api packageBindingsResponseAPIModel) diag.Diagnostics {
+func (m *ApplicationPackageBindingsDataSourceModel) fromAPIModel(ctx context.Context, api client.PackageList) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var items []attr.Value
 	for _, p := range api.Packages {
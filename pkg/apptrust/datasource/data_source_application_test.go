@@ -127,9 +127,13 @@ func TestAccApplicationDataSource_full(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceFqrn, "labels.environment", "test"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "labels.team", "qa"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "user_owners.#", "1"),
-					resource.TestCheckResourceAttr(dataSourceFqrn, "user_owners.0", "test-user"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFqrn, "user_owners.*", "test-user"),
 					resource.TestCheckResourceAttr(dataSourceFqrn, "group_owners.#", "1"),
-					resource.TestCheckResourceAttr(dataSourceFqrn, "group_owners.0", "test-group"),
+					resource.TestCheckTypeSetElemAttr(dataSourceFqrn, "group_owners.*", "test-group"),
+					resource.TestCheckResourceAttrPair(dataSourceFqrn, "created", fqrn, "created"),
+					resource.TestCheckResourceAttrPair(dataSourceFqrn, "created_by", fqrn, "created_by"),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "modified"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "versions_count", "0"),
 				),
 			},
 		},
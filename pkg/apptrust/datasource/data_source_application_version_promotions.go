@@ -16,8 +16,6 @@ package datasource
 
 import (
 	"context"
-	"net/http"
-	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -25,8 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationVersionPromotionsDataSource{}
@@ -36,7 +33,8 @@ func NewApplicationVersionPromotionsDataSource() datasource.DataSource {
 }
 
 type ApplicationVersionPromotionsDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationVersionPromotionsDataSourceModel struct {
@@ -52,30 +50,6 @@ type ApplicationVersionPromotionsDataSourceModel struct {
 	Total          types.Int64  `tfsdk:"total"`
 }
 
-type promotionMessageAPIModel struct {
-	Text string `json:"text"`
-}
-
-type promotionRecordAPIModel struct {
-	ApplicationKey     string                     `json:"application_key"`
-	ApplicationVersion string                     `json:"application_version"`
-	Created            string                     `json:"created"`
-	CreatedBy          string                     `json:"created_by"`
-	CreatedMillis      int64                      `json:"created_millis"`
-	Messages           []promotionMessageAPIModel `json:"messages"`
-	ProjectKey         string                     `json:"project_key"`
-	SourceStage        string                     `json:"source_stage"`
-	Status             string                     `json:"status"`
-	TargetStage        string                     `json:"target_stage"`
-}
-
-type promotionsListAPIModel struct {
-	Promotions []promotionRecordAPIModel `json:"promotions"`
-	Total      int                       `json:"total"`
-	Limit      int                       `json:"limit"`
-	Offset     int                       `json:"offset"`
-}
-
 var promotionRecordAttrType = map[string]attr.Type{
 	"application_key":     types.StringType,
 	"application_version": types.StringType,
@@ -146,7 +120,8 @@ func (d *ApplicationVersionPromotionsDataSource) Configure(ctx context.Context,
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationVersionPromotionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -163,41 +138,18 @@ func (d *ApplicationVersionPromotionsDataSource) Read(ctx context.Context, req d
 		"version":         version,
 	})
 
-	var apiResp promotionsListAPIModel
-	httpReq := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetPathParam("version", version).
-		SetResult(&apiResp)
-
-	if !data.Include.IsNull() && !data.Include.IsUnknown() {
-		httpReq.SetQueryParam("include", data.Include.ValueString())
-	}
-	if !data.Offset.IsNull() && !data.Offset.IsUnknown() {
-		httpReq.SetQueryParam("offset", strconv.FormatInt(data.Offset.ValueInt64(), 10))
-	}
-	if !data.Limit.IsNull() && !data.Limit.IsUnknown() {
-		httpReq.SetQueryParam("limit", strconv.FormatInt(data.Limit.ValueInt64(), 10))
-	}
-	if !data.FilterBy.IsNull() && !data.FilterBy.IsUnknown() {
-		httpReq.SetQueryParam("filter_by", data.FilterBy.ValueString())
-	}
-	if !data.OrderBy.IsNull() && !data.OrderBy.IsUnknown() {
-		httpReq.SetQueryParam("order_by", data.OrderBy.ValueString())
-	}
-	if !data.OrderAsc.IsNull() && !data.OrderAsc.IsUnknown() {
-		httpReq.SetQueryParam("order_asc", strconv.FormatBool(data.OrderAsc.ValueBool()))
-	}
-
-	httpResponse, err := httpReq.Get(resource.ApplicationVersionPromotionsEP)
+	apiResp, err := d.client.Promotions().List(ctx, applicationKey, version, client.PromotionListOptions{
+		Include:  data.Include.ValueString(),
+		FilterBy: data.FilterBy.ValueString(),
+		OrderBy:  data.OrderBy.ValueString(),
+		OrderAsc: data.OrderAsc.ValueBoolPointer(),
+		Pagination: client.Pagination{
+			Offset: data.Offset.ValueInt64Pointer(),
+			Limit:  data.Limit.ValueInt64Pointer(),
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data Source", "Error: "+err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		diags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "application version promotions")
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application version promotions")...)
 		return
 	}
 
@@ -16,15 +16,13 @@ package datasource
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationVersionStatusDataSource{}
@@ -34,7 +32,8 @@ func NewApplicationVersionStatusDataSource() datasource.DataSource {
 }
 
 type ApplicationVersionStatusDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationVersionStatusDataSourceModel struct {
@@ -43,10 +42,6 @@ type ApplicationVersionStatusDataSourceModel struct {
 	VersionReleaseStatus types.String `tfsdk:"version_release_status"`
 }
 
-type versionStatusResponseAPIModel struct {
-	VersionReleaseStatus string `json:"version_release_status"`
-}
-
 func (d *ApplicationVersionStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_version_status"
 }
@@ -75,7 +70,8 @@ func (d *ApplicationVersionStatusDataSource) Configure(ctx context.Context, req
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationVersionStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -92,27 +88,14 @@ func (d *ApplicationVersionStatusDataSource) Read(ctx context.Context, req datas
 		"version":         version,
 	})
 
-	var apiResp versionStatusResponseAPIModel
-	httpResponse, err := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetPathParam("version", version).
-		SetResult(&apiResp).
-		Get(resource.ApplicationVersionStatusEP)
-
+	apiResp, err := d.client.Versions().Status(ctx, applicationKey, version)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data Source", "Error: "+err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			data.VersionReleaseStatus = types.StringNull()
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		diags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "application version status")
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application version status")...)
 		return
 	}
 
@@ -16,8 +16,6 @@ package datasource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,8 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationVersionsDataSource{}
@@ -37,7 +34,8 @@ func NewApplicationVersionsDataSource() datasource.DataSource {
 }
 
 type ApplicationVersionsDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationVersionsDataSourceModel struct {
@@ -52,23 +50,6 @@ type ApplicationVersionsDataSourceModel struct {
 	Total          types.Int64  `tfsdk:"total"`
 }
 
-type applicationVersionItemAPIModel struct {
-	Version       string `json:"version"`
-	Tag           string `json:"tag"`
-	Status        string `json:"status"`
-	ReleaseStatus string `json:"release_status"`
-	CurrentStage  string `json:"current_stage"`
-	CreatedBy     string `json:"created_by"`
-	Created       string `json:"created"`
-}
-
-type applicationVersionsListAPIModel struct {
-	Versions []applicationVersionItemAPIModel `json:"versions"`
-	Total    int                              `json:"total"`
-	Limit    int                              `json:"limit"`
-	Offset   int                              `json:"offset"`
-}
-
 var applicationVersionItemAttrType = map[string]attr.Type{
 	"version":        types.StringType,
 	"tag":            types.StringType,
@@ -142,7 +123,8 @@ func (d *ApplicationVersionsDataSource) Configure(ctx context.Context, req datas
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -155,49 +137,28 @@ func (d *ApplicationVersionsDataSource) Read(ctx context.Context, req datasource
 	applicationKey := data.ApplicationKey.ValueString()
 	tflog.Info(ctx, "Reading application versions", map[string]interface{}{"application_key": applicationKey})
 
-	request := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey)
-
-	if !data.CreatedBy.IsNull() {
-		request = request.SetQueryParam("created_by", data.CreatedBy.ValueString())
-	}
-	if !data.ReleaseStatus.IsNull() {
-		request = request.SetQueryParam("release_status", data.ReleaseStatus.ValueString())
-	}
-	if !data.Tag.IsNull() {
-		request = request.SetQueryParam("tag", data.Tag.ValueString())
-	}
-	if !data.Offset.IsNull() {
-		request = request.SetQueryParam("offset", fmt.Sprintf("%d", data.Offset.ValueInt64()))
-	}
-	if !data.Limit.IsNull() {
-		request = request.SetQueryParam("limit", fmt.Sprintf("%d", data.Limit.ValueInt64()))
-	}
-	if !data.OrderAsc.IsNull() {
-		request = request.SetQueryParam("order_asc", fmt.Sprintf("%t", data.OrderAsc.ValueBool()))
-	}
-
-	var listResp applicationVersionsListAPIModel
-	httpResponse, err := request.SetResult(&listResp).Get(resource.ApplicationVersionsEndpoint)
+	listResp, err := d.client.Versions().List(ctx, applicationKey, client.VersionListOptions{
+		CreatedBy:     data.CreatedBy.ValueString(),
+		ReleaseStatus: data.ReleaseStatus.ValueString(),
+		Tag:           data.Tag.ValueString(),
+		OrderAsc:      data.OrderAsc.ValueBoolPointer(),
+		Pagination: client.Pagination{
+			Offset: data.Offset.ValueInt64Pointer(),
+			Limit:  data.Limit.ValueInt64Pointer(),
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data Source", "Error: "+err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			data.Versions = types.ListNull(types.ObjectType{AttrTypes: applicationVersionItemAttrType})
 			data.Total = types.Int64Value(0)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		diags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "application versions")
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application versions")...)
 		return
 	}
 
-	diags := data.fromAPIModel(ctx, listResp)
+	diags := data.fromAPIModel(ctx, *listResp)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -205,7 +166,7 @@ func (d *ApplicationVersionsDataSource) Read(ctx context.Context, req datasource
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *ApplicationVersionsDataSourceModel) fromAPIModel(ctx context.Context, api applicationVersionsListAPIModel) diag.Diagnostics {
+func (m *ApplicationVersionsDataSourceModel) fromAPIModel(ctx context.Context, api client.VersionList) diag.Diagnostics {
 	var diags diag.Diagnostics
 	m.Total = types.Int64Value(int64(api.Total))
 
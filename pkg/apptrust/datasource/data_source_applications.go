@@ -17,13 +17,11 @@ package datasource
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"net/url"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -32,11 +30,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/jfrog/terraform-provider-shared/util"
-)
-
-const (
-	applicationsEndpoint = "apptrust/api/v1/applications"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &ApplicationsDataSource{}
@@ -46,13 +41,14 @@ func NewApplicationsDataSource() datasource.DataSource {
 }
 
 type ApplicationsDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type ApplicationsDataSourceModel struct {
 	ProjectKey    types.String `tfsdk:"project_key"`
 	Name          types.String `tfsdk:"name"`
-	Owners        types.List   `tfsdk:"owners"`
+	Owners        types.Set    `tfsdk:"owners"`
 	MaturityLevel types.String `tfsdk:"maturity"`
 	Criticality   types.String `tfsdk:"criticality"`
 	Labels        types.List   `tfsdk:"labels"`
@@ -64,20 +60,6 @@ type ApplicationsDataSourceModel struct {
 	Total         types.Int64  `tfsdk:"total"`
 }
 
-// SingleApplicationResponse matches the API response structure for GET /v1/applications
-// The API returns an array of these objects directly
-type SingleApplicationResponse struct {
-	ApplicationKey  string            `json:"application_key"`
-	ApplicationName string            `json:"application_name"`
-	ProjectKey      string            `json:"project_key"`
-	Description     string            `json:"description,omitempty"`
-	MaturityLevel   string            `json:"maturity_level,omitempty"`
-	Criticality     string            `json:"criticality,omitempty"`
-	Labels          map[string]string `json:"labels,omitempty"`
-	UserOwners      []string          `json:"user_owners,omitempty"`
-	GroupOwners     []string          `json:"group_owners,omitempty"`
-}
-
 type ApplicationListItemAPIModel struct {
 	ProjectKey               string `json:"project_key"`
 	ApplicationName          string `json:"application_name"`
@@ -124,19 +106,21 @@ func (d *ApplicationsDataSource) Schema(ctx context.Context, req datasource.Sche
 			"- Ordering is via `order_by` (name or created; default created) and `order_asc` (default false).",
 		Attributes: map[string]schema.Attribute{
 			"project_key": schema.StringAttribute{
-				Description: "The key of the project associated with the application. If not specified, applications from all projects will be returned.",
-				Optional:    true,
+				Description: "The key of the project associated with the application. Defaults to the provider `project_key`. " +
+					"If neither is specified, applications from all projects will be returned.",
+				Optional: true,
+				Computed: true,
 			},
 			"name": schema.StringAttribute{
 				Description: "Filters results by the application name.",
 				Optional:    true,
 			},
-			"owners": schema.ListAttribute{
+			"owners": schema.SetAttribute{
 				Description: "Filters results by application owners (user or group). This filter can be used multiple times.",
 				ElementType: types.StringType,
 				Optional:    true,
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
 						stringvalidator.LengthAtLeast(1),
 					),
 				},
@@ -232,7 +216,8 @@ func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.C
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -243,96 +228,49 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	if data.ProjectKey.IsNull() && d.ProviderData.ProjectKey != "" {
+		data.ProjectKey = types.StringValue(d.ProviderData.ProjectKey)
+	}
+
 	tflog.Info(ctx, "Reading applications datasource", map[string]interface{}{
 		"project_key": data.ProjectKey.ValueString(),
 	})
 
-	// Build query params like unifiedpolicy: url.Values with Set for single, Add for multi. List API uses "maturity" (not "maturity_level").
-	queryValues := url.Values{}
-	if !data.ProjectKey.IsNull() {
-		queryValues.Set("project_key", data.ProjectKey.ValueString())
-	}
-	if !data.Name.IsNull() {
-		queryValues.Set("name", data.Name.ValueString())
-	}
-	if !data.MaturityLevel.IsNull() {
-		queryValues.Set("maturity", data.MaturityLevel.ValueString())
-	}
-	if !data.Criticality.IsNull() {
-		queryValues.Set("criticality", data.Criticality.ValueString())
-	}
-	if !data.OrderBy.IsNull() {
-		queryValues.Set("order_by", data.OrderBy.ValueString())
-	}
-	if !data.OrderAsc.IsNull() {
-		queryValues.Set("order_asc", strconv.FormatBool(data.OrderAsc.ValueBool()))
-	}
-	if !data.Offset.IsNull() {
-		queryValues.Set("offset", strconv.FormatInt(data.Offset.ValueInt64(), 10))
-	}
-	if !data.Limit.IsNull() {
-		queryValues.Set("limit", strconv.FormatInt(data.Limit.ValueInt64(), 10))
+	opts := client.ApplicationListOptions{
+		ProjectKey:  data.ProjectKey.ValueString(),
+		Name:        data.Name.ValueString(),
+		Maturity:    data.MaturityLevel.ValueString(),
+		Criticality: data.Criticality.ValueString(),
+		OrderBy:     data.OrderBy.ValueString(),
+		OrderAsc:    data.OrderAsc.ValueBoolPointer(),
+		Pagination: client.Pagination{
+			Offset: data.Offset.ValueInt64Pointer(),
+			Limit:  data.Limit.ValueInt64Pointer(),
+		},
 	}
-
-	// Handle owners - can be multiple (need to add each one separately)
-	// NOTE: The API supports multiple "owner" query parameters for filtering
-	// Resty will append multiple query params with the same key, which is the expected API behavior
+	// The API supports multiple "owner" and "label" query parameters for filtering
 	if !data.Owners.IsNull() {
-		var owners []string
-		resp.Diagnostics.Append(data.Owners.ElementsAs(ctx, &owners, false)...)
-		if !resp.Diagnostics.HasError() {
-			for _, owner := range owners {
-				queryValues.Add("owner", owner)
-			}
-		}
+		resp.Diagnostics.Append(data.Owners.ElementsAs(ctx, &opts.Owners, false)...)
 	}
 	if !data.Labels.IsNull() {
-		var labels []string
-		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
-		if !resp.Diagnostics.HasError() {
-			for _, label := range labels {
-				queryValues.Add("label", label)
-			}
-		}
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &opts.Labels, false)...)
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// API returns an array of SingleApplicationResponse directly, not wrapped in an object
-	var apiApplications []SingleApplicationResponse
-	response, err := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetQueryParamsFromValues(queryValues).
-		SetResult(&apiApplications).
-		Get(applicationsEndpoint)
-
+	apiApplications, err := d.client.Applications().List(ctx, opts)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Data Source",
-			"An unexpected error occurred while fetching the data source. "+
-				"Please report this issue to the provider developers.\n\n"+
-				"Error: "+err.Error(),
-		)
-		return
-	}
-
-	if response.IsError() {
-		if response.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			// No applications found, return empty list
-			apiApplications = []SingleApplicationResponse{}
+			apiApplications = []client.Application{}
 		} else {
-			resp.Diagnostics.AddError(
-				"Unable to Read Data Source",
-				"An unexpected error occurred while fetching the data source. "+
-					"Please report this issue to the provider developers.\n\n"+
-					"Error: "+response.String(),
-			)
+			resp.Diagnostics.Append(apptrust.HandleClientError(err, "list", "applications")...)
 			return
 		}
 	}
 
-	// Convert API response (array of SingleApplicationResponse) to ApplicationsListAPIModel
+	// Convert API response (array of applications) to ApplicationsListAPIModel
 	// Note: The API doesn't return pagination metadata, so we calculate total from array length
 	// and use the requested limit/offset values
 	limit := 0
@@ -351,7 +289,7 @@ func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		Offset:       offset,
 	}
 
-	// Convert SingleApplicationResponse to ApplicationListItemAPIModel
+	// Convert client.Application to ApplicationListItemAPIModel
 	// Note: API response doesn't include version info in list endpoint
 	for i, app := range apiApplications {
 		result.Applications[i] = ApplicationListItemAPIModel{
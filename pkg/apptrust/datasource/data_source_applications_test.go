@@ -54,6 +54,36 @@ func TestAccApplicationsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccApplicationsDataSource_providerProjectKey(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	projectKey := acctest.AppTrustProjectKey1
+	dataSourceFqrn := "data.apptrust_applications.test"
+
+	config := fmt.Sprintf(`
+		provider "apptrust" {
+			project_key = "%s"
+		}
+
+		data "apptrust_applications" "test" {}
+	`, projectKey)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "project_key", projectKey),
+					resource.TestCheckResourceAttrSet(dataSourceFqrn, "total"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplicationsDataSource_filterByMaturity(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
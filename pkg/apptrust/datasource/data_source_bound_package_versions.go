@@ -16,8 +16,6 @@ package datasource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,8 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
-	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ datasource.DataSource = &BoundPackageVersionsDataSource{}
@@ -37,7 +34,8 @@ func NewBoundPackageVersionsDataSource() datasource.DataSource {
 }
 
 type BoundPackageVersionsDataSource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
+	client       client.Client
 }
 
 type BoundPackageVersionsDataSourceModel struct {
@@ -51,22 +49,6 @@ type BoundPackageVersionsDataSourceModel struct {
 	Total          types.Int64  `tfsdk:"total"`
 }
 
-type boundPackageVersionAPIModel struct {
-	Version     string `json:"version"`
-	VcsURL      string `json:"vcs_url"`
-	VcsBranch   string `json:"vcs_branch"`
-	VcsRevision string `json:"vcs_revision"`
-	Branch      string `json:"branch"`
-	Revision    string `json:"revision"`
-}
-
-type boundPackageVersionsResponseAPIModel struct {
-	Versions []boundPackageVersionAPIModel `json:"versions"`
-	Total    int                           `json:"total"`
-	Offset   int                           `json:"offset"`
-	Limit    int                           `json:"limit"`
-}
-
 var boundPackageVersionAttrType = map[string]attr.Type{
 	"version":      types.StringType,
 	"vcs_url":      types.StringType,
@@ -130,7 +112,8 @@ func (d *BoundPackageVersionsDataSource) Configure(ctx context.Context, req data
 	if req.ProviderData == nil {
 		return
 	}
-	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	d.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	d.client = client.New(d.ProviderData.Client)
 }
 
 func (d *BoundPackageVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -147,41 +130,25 @@ func (d *BoundPackageVersionsDataSource) Read(ctx context.Context, req datasourc
 		"application_key": applicationKey, "package_type": pkgType, "package_name": pkgName,
 	})
 
-	request := d.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetPathParam("type", pkgType).
-		SetPathParam("name", pkgName)
-	if !data.PackageVersion.IsNull() {
-		request = request.SetQueryParam("package_version", data.PackageVersion.ValueString())
-	}
-	if !data.Offset.IsNull() {
-		request = request.SetQueryParam("offset", fmt.Sprintf("%d", data.Offset.ValueInt64()))
-	}
-	if !data.Limit.IsNull() {
-		request = request.SetQueryParam("limit", fmt.Sprintf("%d", data.Limit.ValueInt64()))
-	}
-
-	var apiResp boundPackageVersionsResponseAPIModel
-	httpResponse, err := request.SetResult(&apiResp).Get(resource.ApplicationPackageVersionsEndpoint)
+	apiResp, err := d.client.Packages().ListVersions(ctx, applicationKey, pkgType, pkgName, client.PackageVersionListOptions{
+		PackageVersion: data.PackageVersion.ValueString(),
+		Pagination: client.Pagination{
+			Offset: data.Offset.ValueInt64Pointer(),
+			Limit:  data.Limit.ValueInt64Pointer(),
+		},
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data Source", "Error: "+err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			data.Versions = types.ListNull(types.ObjectType{AttrTypes: boundPackageVersionAttrType})
 			data.Total = types.Int64Value(0)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		diags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "bound package versions")
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "bound package versions")...)
 		return
 	}
 
-	diags := data.fromAPIModel(ctx, apiResp)
+	diags := data.fromAPIModel(ctx, *apiResp)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -189,7 +156,7 @@ func (d *BoundPackageVersionsDataSource) Read(ctx context.Context, req datasourc
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (m *BoundPackageVersionsDataSourceModel) fromAPIModel(ctx context.Context, api boundPackageVersionsResponseAPIModel) diag.Diagnostics {
+func (m *BoundPackageVersionsDataSourceModel) fromAPIModel(ctx context.Context, api client.PackageVersionList) diag.Diagnostics {
 	var diags diag.Diagnostics
 	m.Total = types.Int64Value(int64(api.Total))
 	var items []attr.Value
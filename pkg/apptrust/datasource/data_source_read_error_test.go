@@ -0,0 +1,88 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// readWithServerError reads the data source from a server answering every request with a 500
+// error carrying a request ID, and returns the diagnostics.
+func readWithServerError(t *testing.T, d datasource.DataSourceWithConfigure, config map[string]tftypes.Value) datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "0123456789abcdef")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"INTERNAL","message":"boom"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: apptrust.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attrType := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range config {
+		values[name] = value
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)},
+	}, &resp)
+	return resp
+}
+
+func TestApplicationDataSources_apiError(t *testing.T) {
+	for name, tc := range map[string]struct {
+		dataSource datasource.DataSourceWithConfigure
+		config     map[string]tftypes.Value
+	}{
+		"apptrust_application": {
+			NewApplicationDataSource().(datasource.DataSourceWithConfigure),
+			map[string]tftypes.Value{"application_key": tftypes.NewValue(tftypes.String, "my-app")},
+		},
+		"apptrust_applications": {NewApplicationsDataSource().(datasource.DataSourceWithConfigure), nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := readWithServerError(t, tc.dataSource, tc.config)
+			if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary() != "Server Error" {
+				t.Fatalf("expected a server error diagnostic, got %v", resp.Diagnostics)
+			}
+			if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, "boom") || !strings.HasSuffix(detail, "Request ID: 0123456789abcdef") {
+				t.Errorf("expected the API message and the request ID in %q", detail)
+			}
+		})
+	}
+}
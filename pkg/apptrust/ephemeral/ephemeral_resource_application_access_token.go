@@ -0,0 +1,222 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ephemeral
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+const (
+	defaultTokenRole      = "Developer"
+	defaultTokenExpiresIn = 3600
+
+	// tokenIDPrivateKey stores the ID of the created token between Open and Close.
+	tokenIDPrivateKey = "token_id"
+)
+
+var _ ephemeral.EphemeralResource = &ApplicationAccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApplicationAccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &ApplicationAccessTokenEphemeralResource{}
+
+func NewApplicationAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ApplicationAccessTokenEphemeralResource{
+		TypeName: "apptrust_application_access_token",
+	}
+}
+
+type ApplicationAccessTokenEphemeralResource struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type ApplicationAccessTokenEphemeralResourceModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Role           types.String `tfsdk:"role"`
+	ExpiresIn      types.Int64  `tfsdk:"expires_in"`
+	Description    types.String `tfsdk:"description"`
+	RevokeOnClose  types.Bool   `tfsdk:"revoke_on_close"`
+	ProjectKey     types.String `tfsdk:"project_key"`
+	TokenID        types.String `tfsdk:"token_id"`
+	AccessToken    types.String `tfsdk:"access_token"`
+	Scope          types.String `tfsdk:"scope"`
+}
+
+func (r *ApplicationAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ApplicationAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived access token scoped to the project of an AppTrust application, e.g. for a CI job uploading builds " +
+			"for the application. The token is never stored in the plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application whose project the token is scoped to.",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: fmt.Sprintf("Project role granted to the token, e.g. Developer, Contributor or Viewer. Defaults to `%s`.", defaultTokenRole),
+				Optional:    true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Description: fmt.Sprintf("Lifetime of the token in seconds. Defaults to `%d`.", defaultTokenExpiresIn),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the token, shown in the platform token list.",
+				Optional:    true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Revoke the token when Terraform no longer needs it, at the end of the run. Set to `false` when the token must stay valid " +
+					"until it expires, e.g. when it is handed over to a later CI step. Defaults to `true`.",
+				Optional: true,
+			},
+			"project_key": schema.StringAttribute{
+				Description: "Project key of the application.",
+				Computed:    true,
+			},
+			"token_id": schema.StringAttribute{
+				Description: "ID of the created token.",
+				Computed:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "The access token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "Scope of the token.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
+}
+
+func (r *ApplicationAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	r.ProviderData.Usage.RecordResource(r.TypeName, "OPEN")
+
+	var data ApplicationAccessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the project of the application, as the application resource does on refresh.
+	applicationKey := data.ApplicationKey.ValueString()
+	application, err := r.client.Applications().Get(ctx, applicationKey)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("application_key"),
+				"Application not found",
+				fmt.Sprintf("Application '%s' does not exist or is not visible to the provider credentials.", applicationKey),
+			)
+			return
+		}
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application")...)
+		return
+	}
+
+	role := defaultTokenRole
+	if !data.Role.IsNull() {
+		role = data.Role.ValueString()
+	}
+	expiresIn := int64(defaultTokenExpiresIn)
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = data.ExpiresIn.ValueInt64()
+	}
+	description := fmt.Sprintf("Terraform ephemeral token for AppTrust application %s", applicationKey)
+	if !data.Description.IsNull() {
+		description = data.Description.ValueString()
+	}
+
+	token, err := r.client.Tokens().Create(ctx, client.CreateTokenRequest{
+		Scope:       fmt.Sprintf("applied-permissions/roles:%s:%s", application.ProjectKey, role),
+		ProjectKey:  application.ProjectKey,
+		ExpiresIn:   expiresIn,
+		Description: description,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "create", "access token")...)
+		return
+	}
+	tflog.Debug(ctx, "Created application access token", map[string]interface{}{
+		"application_key": applicationKey,
+		"project_key":     application.ProjectKey,
+		"token_id":        token.TokenID,
+		"expires_in":      token.ExpiresIn,
+	})
+
+	if data.RevokeOnClose.IsNull() || data.RevokeOnClose.ValueBool() {
+		tokenID, err := json.Marshal(token.TokenID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Store Token ID", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, tokenIDPrivateKey, tokenID)...)
+	}
+
+	data.ProjectKey = types.StringValue(application.ProjectKey)
+	data.TokenID = types.StringValue(token.TokenID)
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.Scope = types.StringValue(token.Scope)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the token, unless revoke_on_close is false. A token that no longer exists is
+// not an error, e.g. when it has already expired.
+func (r *ApplicationAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	value, diags := req.Private.GetKey(ctx, tokenIDPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || value == nil {
+		return
+	}
+
+	var tokenID string
+	if err := json.Unmarshal(value, &tokenID); err != nil {
+		resp.Diagnostics.AddError("Unable to Read Token ID", err.Error())
+		return
+	}
+
+	err := r.client.Tokens().Revoke(ctx, tokenID)
+	if err == nil || client.IsNotFound(err) {
+		return
+	}
+	resp.Diagnostics.Append(apptrust.HandleClientError(err, "revoke", "access token")...)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ephemeral_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+)
+
+// The echo provider copies the ephemeral result into the state of echo.test, so the tests can
+// check values that are otherwise never persisted.
+var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"apptrust": acctest.ProtoV6ProviderFactories["apptrust"],
+	"echo":     echoprovider.NewProviderServer(),
+}
+
+func TestAccApplicationAccessTokenEphemeralResource_basic(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-token-", "apptrust_application")
+	projectKey := acctest.AppTrustProjectKey1
+	appKey := fmt.Sprintf("app-%d", id)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+
+		ephemeral "apptrust_application_access_token" "test" {
+			application_key = apptrust_application.%s.application_key
+			role            = "Viewer"
+			expires_in      = 600
+		}
+
+		provider "echo" {
+			data = ephemeral.apptrust_application_access_token.test
+		}
+
+		resource "echo" "test" {}
+	`, name, appKey, name, projectKey, name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: acctest.TestAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("project_key"), knownvalue.StringExact(projectKey)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scope"), knownvalue.StringExact(fmt.Sprintf("applied-permissions/roles:%s:Viewer", projectKey))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationAccessTokenEphemeralResource_applicationNotFound(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					ephemeral "apptrust_application_access_token" "test" {
+						application_key = "non-existent-app"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Application not found`),
+			},
+		},
+	})
+}
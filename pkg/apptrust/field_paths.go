@@ -0,0 +1,105 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// FieldPath is the attribute an API request field is sent from.
+type FieldPath struct {
+	Path path.Path
+	// MapKeys is true when the field is a map, so the segment following it is a map key (labels.env)
+	// rather than a nested attribute name (builds[0].number). The key extends to the next index,
+	// dots included (labels.app.kubernetes.io).
+	MapKeys bool
+	// Set is true when the field is a set. Set elements are addressed by value rather than by
+	// index, so the path stops at the set (user_owners[1] is reported on user_owners).
+	Set bool
+}
+
+// FieldPaths maps the field names reported by AppTrust API errors to the attributes of a
+// resource, e.g. "sources.builds" to source_builds. List indexes, map keys and nested attribute
+// names following a mapped field are appended to its path.
+type FieldPaths map[string]FieldPath
+
+// Path returns the attribute path of an API error field, e.g. source_builds[0].number for
+// sources.builds[0].number or labels["env"] for labels.env. It returns false for fields not in
+// the table.
+func (f FieldPaths) Path(field string) (path.Path, bool) {
+	// The longest matching field wins, so "sources.builds" is preferred over "sources".
+	var (
+		match string
+		found bool
+	)
+	for name := range f {
+		if (field == name || strings.HasPrefix(field, name+".") || strings.HasPrefix(field, name+"[")) && len(name) >= len(match) {
+			match, found = name, true
+		}
+	}
+	if !found {
+		return path.Path{}, false
+	}
+
+	fieldPath := f[match]
+	p := fieldPath.Path
+	if fieldPath.Set {
+		return p, true
+	}
+	mapKey := fieldPath.MapKeys
+	rest := field[len(match):]
+	for rest != "" {
+		switch rest[0] {
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return p, true
+			}
+			segment := strings.Trim(rest[1:end], `"'`)
+			if index, err := strconv.ParseInt(segment, 10, 64); err == nil && !mapKey {
+				p = p.AtListIndex(int(index))
+			} else {
+				p = p.AtMapKey(segment)
+			}
+			rest = rest[end+1:]
+		case '.':
+			// Map keys may contain dots (labels.app.kubernetes.io), so a key runs up to the next
+			// index rather than the next dot.
+			separators := ".["
+			if mapKey {
+				separators = "["
+			}
+			end := strings.IndexAny(rest[1:], separators)
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			segment := rest[1 : end+1]
+			if mapKey {
+				p = p.AtMapKey(segment)
+			} else {
+				p = p.AtName(segment)
+			}
+			rest = rest[end+1:]
+		default:
+			return p, true
+		}
+		// Only the segment directly after a map field is a key; map values may be lists.
+		mapKey = false
+	}
+	return p, true
+}
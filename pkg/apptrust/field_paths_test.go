@@ -0,0 +1,115 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var testFieldPaths = apptrust.FieldPaths{
+	"application_name": {Path: path.Root("application_name")},
+	"labels":           {Path: path.Root("labels"), MapKeys: true},
+	"sources":          {Path: path.Root("sources")},
+	"sources.builds":   {Path: path.Root("source_builds")},
+	"properties":       {Path: path.Root("properties"), MapKeys: true},
+	"user_owners":      {Path: path.Root("user_owners"), Set: true},
+}
+
+func TestFieldPaths_Path(t *testing.T) {
+	tests := []struct {
+		field string
+		want  path.Path
+		found bool
+	}{
+		{"application_name", path.Root("application_name"), true},
+		{"labels.env", path.Root("labels").AtMapKey("env"), true},
+		{`labels["env"]`, path.Root("labels").AtMapKey("env"), true},
+		{"labels.app.kubernetes.io", path.Root("labels").AtMapKey("app.kubernetes.io"), true},
+		{`labels["app.kubernetes.io"]`, path.Root("labels").AtMapKey("app.kubernetes.io"), true},
+		{"properties.build.team[1]", path.Root("properties").AtMapKey("build.team").AtListIndex(1), true},
+		{"sources.builds[0].number", path.Root("source_builds").AtListIndex(0).AtName("number"), true},
+		{"sources.builds", path.Root("source_builds"), true},
+		{"properties.team[1]", path.Root("properties").AtMapKey("team").AtListIndex(1), true},
+		{"user_owners[1]", path.Root("user_owners"), true},
+		{"application_names", path.Path{}, false},
+		{"description", path.Path{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			got, found := testFieldPaths.Path(tt.field)
+			if found != tt.found || !got.Equal(tt.want) {
+				t.Errorf("Path(%q) = %s, %t, want %s, %t", tt.field, got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func testResponse(statusCode int, body string) *resty.Response {
+	return (&resty.Response{
+		RawResponse: &http.Response{StatusCode: statusCode},
+	}).SetBody([]byte(body))
+}
+
+func TestHandleAPIErrorWithPaths(t *testing.T) {
+	response := testResponse(http.StatusBadRequest, `{"errors":[
+		{"code":"invalid","message":"must not be blank","field":"application_name"},
+		{"code":"invalid","message":"invalid label value","field":"labels.env"},
+		{"code":"invalid","message":"unknown owner","field":"owners"}
+	]}`)
+
+	diags := apptrust.HandleAPIErrorWithPaths(response, "create", "application", testFieldPaths)
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %d: %v", len(diags), diags)
+	}
+	for i, want := range []path.Path{path.Root("application_name"), path.Root("labels").AtMapKey("env")} {
+		attrDiag, ok := diags[i].(diag.DiagnosticWithPath)
+		if !ok || !attrDiag.Path().Equal(want) {
+			t.Errorf("diagnostic %d: expected an attribute error at %s, got %v", i, want, diags[i])
+		}
+		if diags[i].Summary() != "Invalid Request" {
+			t.Errorf("diagnostic %d: unexpected summary %q", i, diags[i].Summary())
+		}
+	}
+	if _, ok := diags[2].(diag.DiagnosticWithPath); ok {
+		t.Errorf("expected the unmapped field to stay a general error, got %v", diags[2])
+	}
+	if !strings.Contains(diags[2].Detail(), "unknown owner") {
+		t.Errorf("expected the unmapped error message in %q", diags[2].Detail())
+	}
+}
+
+func TestHandleAPIErrorWithPaths_fallback(t *testing.T) {
+	for name, body := range map[string]string{
+		"no field":       `{"errors":[{"code":"invalid","message":"One or more fields failed validation"}]}`,
+		"unmapped field": `{"errors":[{"code":"invalid","message":"unknown owner","field":"owners"}]}`,
+		"not json":       `Bad Request`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			response := testResponse(http.StatusBadRequest, body)
+			got := apptrust.HandleAPIErrorWithPaths(response, "create", "application", testFieldPaths)
+			want := apptrust.HandleAPIErrorWithType(response, "create", "application")
+			if !got.Equal(want) {
+				t.Errorf("expected the HandleAPIErrorWithType diagnostics %v, got %v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &BoundPackageIDFunction{}
+
+func NewBoundPackageIDFunction() function.Function {
+	return &BoundPackageIDFunction{}
+}
+
+type BoundPackageIDFunction struct{}
+
+func (f *BoundPackageIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bound_package_id"
+}
+
+func (f *BoundPackageIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a bound package ID",
+		MarkdownDescription: "Returns the `application_key:package_type:package_name:package_version` ID used by `apptrust_bound_package`, " +
+			"e.g. to write `import` blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "application_key",
+				Description: "The application key.",
+			},
+			function.StringParameter{
+				Name:        "package_type",
+				Description: "The package type, e.g. npm or maven.",
+			},
+			function.StringParameter{
+				Name:        "package_name",
+				Description: "The package name.",
+			},
+			function.StringParameter{
+				Name:        "package_version",
+				Description: "The package version.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BoundPackageIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id apptrust.BoundPackageID
+	resp.Error = req.Arguments.Get(ctx, &id.ApplicationKey, &id.PackageType, &id.PackageName, &id.PackageVersion)
+	if resp.Error != nil {
+		return
+	}
+
+	// The application key, package type and version must not contain the separator, or the ID
+	// could not be parsed back. The package name may.
+	for position, value := range []string{id.ApplicationKey, id.PackageType, id.PackageName, id.PackageVersion} {
+		if value == "" {
+			resp.Error = function.NewArgumentFuncError(int64(position), "Value must not be empty")
+			return
+		}
+		if position != 2 && strings.Contains(value, ":") {
+			resp.Error = function.NewArgumentFuncError(int64(position), "Value must not contain ':'")
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, id.String())
+}
@@ -0,0 +1,34 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apptrust_function "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/function"
+)
+
+func TestBoundPackageIDFunction(t *testing.T) {
+	got, err := runFunction(t, apptrust_function.NewBoundPackageIDFunction(), "my-app", "maven", "org.example:lib", "1.2.0")
+	if err != nil || !got.Equal(types.StringValue("my-app:maven:org.example:lib:1.2.0")) {
+		t.Errorf("unexpected result %s, %v", got, err)
+	}
+
+	_, err = runFunction(t, apptrust_function.NewBoundPackageIDFunction(), "my-app", "npm", "left-pad", "1.0.0:rc")
+	checkFuncError(t, err, 3, "must not contain ':'")
+	_, err = runFunction(t, apptrust_function.NewBoundPackageIDFunction(), "", "npm", "left-pad", "1.0.0")
+	checkFuncError(t, err, 0, "must not be empty")
+}
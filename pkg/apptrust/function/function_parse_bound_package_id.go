@@ -0,0 +1,87 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &ParseBoundPackageIDFunction{}
+
+var boundPackageIDAttributeTypes = map[string]attr.Type{
+	"application_key": types.StringType,
+	"package_type":    types.StringType,
+	"package_name":    types.StringType,
+	"package_version": types.StringType,
+}
+
+func NewParseBoundPackageIDFunction() function.Function {
+	return &ParseBoundPackageIDFunction{}
+}
+
+type ParseBoundPackageIDFunction struct{}
+
+func (f *ParseBoundPackageIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_bound_package_id"
+}
+
+func (f *ParseBoundPackageIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a bound package ID",
+		MarkdownDescription: "Splits the `application_key:package_type:package_name:package_version` ID of `apptrust_bound_package` into an object " +
+			"with `application_key`, `package_type`, `package_name` and `package_version`. The package name may contain `:` " +
+			"(e.g. Maven `group:artifact`): it is everything between the package type and the last `:`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Bound package ID, e.g. my-app:npm:left-pad:1.3.0.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: boundPackageIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseBoundPackageIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := apptrust.ParseBoundPackageID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(boundPackageIDAttributeTypes, map[string]attr.Value{
+		"application_key": types.StringValue(parsed.ApplicationKey),
+		"package_type":    types.StringValue(parsed.PackageType),
+		"package_name":    types.StringValue(parsed.PackageName),
+		"package_version": types.StringValue(parsed.PackageVersion),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
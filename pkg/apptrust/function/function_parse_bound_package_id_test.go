@@ -0,0 +1,41 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function_test
+
+import (
+	"maps"
+	"testing"
+
+	apptrust_function "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/function"
+)
+
+func TestParseBoundPackageIDFunction(t *testing.T) {
+	got, err := runFunction(t, apptrust_function.NewParseBoundPackageIDFunction(), "my-app:maven:org.example:lib:1.2.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"application_key": "my-app",
+		"package_type":    "maven",
+		"package_name":    "org.example:lib",
+		"package_version": "1.2.0",
+	}
+	if attributes := objectAttributes(t, got); !maps.Equal(attributes, want) {
+		t.Errorf("parse_bound_package_id() = %v, want %v", attributes, want)
+	}
+
+	_, err = runFunction(t, apptrust_function.NewParseBoundPackageIDFunction(), "my-app:npm:left-pad")
+	checkFuncError(t, err, 0, "application_key:package_type:package_name:package_version")
+}
@@ -0,0 +1,84 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &ParsePromotionIDFunction{}
+
+var promotionIDAttributeTypes = map[string]attr.Type{
+	"application_key": types.StringType,
+	"version":         types.StringType,
+	"stage":           types.StringType,
+}
+
+func NewParsePromotionIDFunction() function.Function {
+	return &ParsePromotionIDFunction{}
+}
+
+type ParsePromotionIDFunction struct{}
+
+func (f *ParsePromotionIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_promotion_id"
+}
+
+func (f *ParsePromotionIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a promotion or rollback ID",
+		MarkdownDescription: "Splits the `application_key:version:stage` ID of `apptrust_application_version_promotion` (target stage) and " +
+			"`apptrust_application_version_rollback` (from stage) into an object with `application_key`, `version` and `stage`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Promotion or rollback ID, e.g. my-app:1.0.0:QA.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: promotionIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParsePromotionIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := apptrust.ParseStageID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(promotionIDAttributeTypes, map[string]attr.Value{
+		"application_key": types.StringValue(parsed.ApplicationKey),
+		"version":         types.StringValue(parsed.Version),
+		"stage":           types.StringValue(parsed.Stage),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &ParseVersionIDFunction{}
+
+var versionIDAttributeTypes = map[string]attr.Type{
+	"application_key": types.StringType,
+	"version":         types.StringType,
+}
+
+func NewParseVersionIDFunction() function.Function {
+	return &ParseVersionIDFunction{}
+}
+
+type ParseVersionIDFunction struct{}
+
+func (f *ParseVersionIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_version_id"
+}
+
+func (f *ParseVersionIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an application version ID",
+		MarkdownDescription: "Splits the `application_key:version` ID of `apptrust_application_version` and `apptrust_application_version_release` " +
+			"into an object with `application_key` and `version`. The version is everything after the first `:`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Application version ID, e.g. my-app:1.0.0.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: versionIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseVersionIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := apptrust.ParseVersionID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(versionIDAttributeTypes, map[string]attr.Value{
+		"application_key": types.StringValue(parsed.ApplicationKey),
+		"version":         types.StringValue(parsed.Version),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
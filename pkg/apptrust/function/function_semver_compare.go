@@ -0,0 +1,74 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &SemverCompareFunction{}
+
+func NewSemverCompareFunction() function.Function {
+	return &SemverCompareFunction{}
+}
+
+type SemverCompareFunction struct{}
+
+func (f *SemverCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_compare"
+}
+
+func (f *SemverCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two semantic versions",
+		MarkdownDescription: "Returns `-1`, `0` or `1` when the first version is lower than, equal to or greater than the second one. " +
+			"Pre-releases are lower than the release they precede (`1.0.0-rc.1` < `1.0.0`), and a leading `v` is ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "version1",
+				Description: "First semantic version.",
+			},
+			function.StringParameter{
+				Name:        "version2",
+				Description: "Second semantic version.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *SemverCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version1, version2 string
+	resp.Error = req.Arguments.Get(ctx, &version1, &version2)
+	if resp.Error != nil {
+		return
+	}
+
+	// Report which argument is invalid.
+	if _, err := apptrust.CompareVersions(version1, version1); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	result, err := apptrust.CompareVersions(version1, version2)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, int64(result))
+}
@@ -0,0 +1,44 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apptrust_function "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/function"
+)
+
+func TestSemverCompareFunction(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int64
+	}{
+		{"1.2.0", "1.10.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"v2.0.0", "2.0.0", 0},
+	}
+	for _, tt := range tests {
+		got, err := runFunction(t, apptrust_function.NewSemverCompareFunction(), tt.a, tt.b)
+		if err != nil || !got.Equal(types.Int64Value(tt.want)) {
+			t.Errorf("semver_compare(%q, %q) = %s, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+
+	_, err := runFunction(t, apptrust_function.NewSemverCompareFunction(), "latest", "1.0.0")
+	checkFuncError(t, err, 0, `invalid version "latest"`)
+	_, err = runFunction(t, apptrust_function.NewSemverCompareFunction(), "1.0.0", "latest")
+	checkFuncError(t, err, 1, `invalid version "latest"`)
+}
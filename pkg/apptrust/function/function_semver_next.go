@@ -0,0 +1,76 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+var _ function.Function = &SemverNextFunction{}
+
+func NewSemverNextFunction() function.Function {
+	return &SemverNextFunction{}
+}
+
+type SemverNextFunction struct{}
+
+func (f *SemverNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_next"
+}
+
+func (f *SemverNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Increment a semantic version",
+		MarkdownDescription: "Returns the version following `version` when incrementing `part` (`major`, `minor` or `patch`), " +
+			"e.g. `semver_next(\"1.4.2\", \"minor\")` is `1.5.0`. Lower parts are reset to 0 and pre-release and build metadata are dropped; " +
+			"the next version of a pre-release is the release it precedes when that release already increments `part` " +
+			"(`1.5.0-rc.1` becomes `1.5.0` for `minor` or `patch`, and `2.0.0` for `major`). A leading `v` is kept.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "version",
+				Description: "Current semantic version.",
+			},
+			function.StringParameter{
+				Name:        "part",
+				Description: "Part to increment: major, minor or patch.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SemverNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var version, part string
+	resp.Error = req.Arguments.Get(ctx, &version, &part)
+	if resp.Error != nil {
+		return
+	}
+
+	// Report which argument is invalid.
+	if _, err := apptrust.NextVersion(version, "patch"); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	next, err := apptrust.NextVersion(version, part)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, next)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apptrust_function "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/function"
+)
+
+func TestSemverNextFunction(t *testing.T) {
+	tests := []struct {
+		version, part, want string
+	}{
+		{"1.4.2", "major", "2.0.0"},
+		{"1.4.2", "minor", "1.5.0"},
+		{"v1.4.2", "patch", "v1.4.3"},
+		{"1.5.0-rc.1", "patch", "1.5.0"},
+		{"1.5.0-rc.1", "minor", "1.5.0"},
+		{"1.5.0-rc.1", "major", "2.0.0"},
+	}
+	for _, tt := range tests {
+		got, err := runFunction(t, apptrust_function.NewSemverNextFunction(), tt.version, tt.part)
+		if err != nil || !got.Equal(types.StringValue(tt.want)) {
+			t.Errorf("semver_next(%q, %q) = %s, %v, want %q", tt.version, tt.part, got, err, tt.want)
+		}
+	}
+
+	_, err := runFunction(t, apptrust_function.NewSemverNextFunction(), "latest", "patch")
+	checkFuncError(t, err, 0, `invalid version "latest"`)
+	_, err = runFunction(t, apptrust_function.NewSemverNextFunction(), "1.4.2", "build")
+	checkFuncError(t, err, 1, "must be one of major, minor or patch")
+}
@@ -0,0 +1,72 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls the Run method of f with string arguments, as Terraform would, and returns
+// the result and error.
+func runFunction(t *testing.T, f function.Function, args ...string) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	var definition function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	result, err := definition.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values := make([]attr.Value, len(args))
+	for i, arg := range args {
+		values[i] = types.StringValue(arg)
+	}
+	resp := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(values)}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// checkFuncError fails unless err is an error of the argument at position containing text.
+func checkFuncError(t *testing.T, err *function.FuncError, position int64, text string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("expected an error containing %q", text)
+	}
+	if err.FunctionArgument == nil || *err.FunctionArgument != position || !strings.Contains(err.Text, text) {
+		t.Errorf("expected an error of argument %d containing %q, got %v", position, text, err)
+	}
+}
+
+// objectAttributes returns the string attributes of an object result.
+func objectAttributes(t *testing.T, value attr.Value) map[string]string {
+	t.Helper()
+	object, ok := value.(types.Object)
+	if !ok {
+		t.Fatalf("expected an object, got %T", value)
+	}
+	attributes := map[string]string{}
+	for name, attribute := range object.Attributes() {
+		attributes[name] = attribute.(types.String).ValueString()
+	}
+	return attributes
+}
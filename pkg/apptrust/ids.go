@@ -0,0 +1,99 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"fmt"
+	"strings"
+)
+
+// idSeparator separates the parts of the composite IDs of the AppTrust resources.
+const idSeparator = ":"
+
+// VersionID identifies an application version, formatted as application_key:version. It is the
+// ID of apptrust_application_version and apptrust_application_version_release.
+type VersionID struct {
+	ApplicationKey string
+	Version        string
+}
+
+func (id VersionID) String() string {
+	return id.ApplicationKey + idSeparator + id.Version
+}
+
+// ParseVersionID parses an application_key:version ID. The version is everything after the first
+// separator.
+func ParseVersionID(id string) (VersionID, error) {
+	applicationKey, version, ok := strings.Cut(id, idSeparator)
+	if !ok || applicationKey == "" || version == "" {
+		return VersionID{}, fmt.Errorf("ID %q must be application_key:version (e.g. my-app:1.0.0)", id)
+	}
+	return VersionID{ApplicationKey: applicationKey, Version: version}, nil
+}
+
+// StageID identifies an application version in a lifecycle stage, formatted as
+// application_key:version:stage. It is the ID of apptrust_application_version_promotion (target
+// stage) and apptrust_application_version_rollback (from stage).
+type StageID struct {
+	ApplicationKey string
+	Version        string
+	Stage          string
+}
+
+func (id StageID) String() string {
+	return strings.Join([]string{id.ApplicationKey, id.Version, id.Stage}, idSeparator)
+}
+
+// ParseStageID parses an application_key:version:stage ID.
+func ParseStageID(id string) (StageID, error) {
+	parts := strings.Split(id, idSeparator)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return StageID{}, fmt.Errorf("ID %q must be application_key:version:stage (e.g. my-app:1.0.0:QA)", id)
+	}
+	return StageID{ApplicationKey: parts[0], Version: parts[1], Stage: parts[2]}, nil
+}
+
+// BoundPackageID identifies a package version bound to an application, formatted as
+// application_key:package_type:package_name:package_version. It is the ID of apptrust_bound_package.
+type BoundPackageID struct {
+	ApplicationKey string
+	PackageType    string
+	PackageName    string
+	PackageVersion string
+}
+
+func (id BoundPackageID) String() string {
+	return strings.Join([]string{id.ApplicationKey, id.PackageType, id.PackageName, id.PackageVersion}, idSeparator)
+}
+
+// ParseBoundPackageID parses an application_key:package_type:package_name:package_version ID. The
+// package name may itself contain the separator (e.g. Maven group:artifact), so the first two parts
+// are the application key and package type, the last part is the version and the rest is the name.
+func ParseBoundPackageID(id string) (BoundPackageID, error) {
+	parts := strings.Split(id, idSeparator)
+	if len(parts) < 4 {
+		return BoundPackageID{}, fmt.Errorf("ID %q must be application_key:package_type:package_name:package_version (e.g. my-app:npm:left-pad:1.3.0)", id)
+	}
+	parsed := BoundPackageID{
+		ApplicationKey: parts[0],
+		PackageType:    parts[1],
+		PackageName:    strings.Join(parts[2:len(parts)-1], idSeparator),
+		PackageVersion: parts[len(parts)-1],
+	}
+	if parsed.ApplicationKey == "" || parsed.PackageType == "" || parsed.PackageName == "" || parsed.PackageVersion == "" {
+		return BoundPackageID{}, fmt.Errorf("ID %q must be application_key:package_type:package_name:package_version with no empty part", id)
+	}
+	return parsed, nil
+}
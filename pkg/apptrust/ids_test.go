@@ -0,0 +1,76 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"testing"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+func TestParseVersionID(t *testing.T) {
+	id, err := apptrust.ParseVersionID("my-app:1.0.0+build:7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.ApplicationKey != "my-app" || id.Version != "1.0.0+build:7" {
+		t.Errorf("unexpected version ID: %+v", id)
+	}
+	if id.String() != "my-app:1.0.0+build:7" {
+		t.Errorf("expected the ID to round trip, got %q", id.String())
+	}
+
+	for _, invalid := range []string{"", "my-app", "my-app:", ":1.0.0"} {
+		if _, err := apptrust.ParseVersionID(invalid); err == nil {
+			t.Errorf("expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestParseStageID(t *testing.T) {
+	id, err := apptrust.ParseStageID("my-app:1.0.0:QA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != (apptrust.StageID{ApplicationKey: "my-app", Version: "1.0.0", Stage: "QA"}) {
+		t.Errorf("unexpected stage ID: %+v", id)
+	}
+
+	for _, invalid := range []string{"my-app:1.0.0", "my-app:1.0.0:QA:extra", "my-app::QA"} {
+		if _, err := apptrust.ParseStageID(invalid); err == nil {
+			t.Errorf("expected an error parsing %q", invalid)
+		}
+	}
+}
+
+func TestParseBoundPackageID(t *testing.T) {
+	id, err := apptrust.ParseBoundPackageID("my-app:maven:org.example:lib:1.2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := apptrust.BoundPackageID{ApplicationKey: "my-app", PackageType: "maven", PackageName: "org.example:lib", PackageVersion: "1.2.0"}
+	if id != want {
+		t.Errorf("expected %+v, got %+v", want, id)
+	}
+	if id.String() != "my-app:maven:org.example:lib:1.2.0" {
+		t.Errorf("expected the ID to round trip, got %q", id.String())
+	}
+
+	for _, invalid := range []string{"my-app:npm:left-pad", "my-app:npm::1.0.0", "my-app:npm:left-pad:"} {
+		if _, err := apptrust.ParseBoundPackageID(invalid); err == nil {
+			t.Errorf("expected an error parsing %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// ProviderMetadata is passed by the provider to every resource and data source. It extends the
+// shared JFrog provider metadata with AppTrust specific provider settings.
+type ProviderMetadata struct {
+	util.ProviderMetadata
+
+	// DefaultLabels are merged under the labels of every apptrust_application.
+	DefaultLabels map[string]string
+	// ProjectKey is used wherever a project key is accepted but not set. Empty when not configured.
+	ProjectKey string
+	// Usage aggregates usage telemetry. Nil when telemetry is disabled.
+	Usage *UsageReporter
+	// Capabilities tells which version dependent AppTrust features the platform supports.
+	Capabilities Capabilities
+}
+
+// MergeLabels returns defaults overridden by labels. The result is never nil.
+func MergeLabels(defaults, labels map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(labels))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
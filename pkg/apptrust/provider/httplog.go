@@ -0,0 +1,246 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem of the request and response logs. It follows the provider
+// log level (TF_LOG_PROVIDER=TRACE) and can be set on its own with TF_LOG_PROVIDER_APPTRUST_HTTP.
+const httpLogSubsystem = "apptrust_http"
+
+const (
+	redactedValue = "***"
+
+	// maxLoggedBodyBytes truncates large bodies, e.g. long version or package lists.
+	maxLoggedBodyBytes = 64 * 1024
+)
+
+// Headers carrying credentials. Their values are never logged.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-JFrog-Art-Api",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// JSON body fields carrying credentials, e.g. the OIDC token exchange request and response.
+// Matched case-insensitively at any depth.
+var redactedBodyFields = map[string]bool{
+	"access_token":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"subject_token": true,
+	"token":         true,
+	"password":      true,
+	"api_key":       true,
+	"apikey":        true,
+	"client_secret": true,
+}
+
+// httpLogger logs every AppTrust API call at TRACE level in the apptrust_http subsystem. Besides
+// the headers and body fields above, the values of the Sensitive provider attributes (access
+// token, API key, proxy password, client key) are masked wherever they appear in a log entry.
+type httpLogger struct {
+	mu      sync.RWMutex
+	secrets []string
+}
+
+// addSecrets masks values in all following log entries. Empty values are ignored.
+func (l *httpLogger) addSecrets(values ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, value := range values {
+		if value != "" && !slices.Contains(l.secrets, value) {
+			l.secrets = append(l.secrets, value)
+		}
+	}
+}
+
+func (l *httpLogger) install(restyClient *resty.Client) {
+	restyClient.OnAfterResponse(l.logResponse)
+	restyClient.OnError(l.logError)
+}
+
+func (l *httpLogger) currentSecrets() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.Clone(l.secrets)
+}
+
+// context returns ctx with the apptrust_http subsystem. Resource operations pass their own
+// context to the client, so the subsystem is set up per request rather than once in Configure.
+func (l *httpLogger) context(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "APPTRUST_HTTP"))
+
+	if secrets := l.currentSecrets(); len(secrets) > 0 {
+		ctx = tflog.SubsystemMaskLogStrings(ctx, httpLogSubsystem, secrets...)
+	}
+	return ctx
+}
+
+// headers redacts header like redactHeaders and masks the secrets in the other values. tflog
+// only masks the message and string fields, not the values of the header map.
+func (l *httpLogger) headers(header http.Header) map[string]string {
+	redacted := redactHeaders(header)
+	for _, secret := range l.currentSecrets() {
+		for name, value := range redacted {
+			redacted[name] = strings.ReplaceAll(value, secret, redactedValue)
+		}
+	}
+	return redacted
+}
+
+// logResponse runs for every attempt that received a response, including retried ones.
+func (l *httpLogger) logResponse(_ *resty.Client, response *resty.Response) error {
+	request := response.Request
+	if request == nil {
+		return nil
+	}
+
+	tflog.SubsystemTrace(l.context(request.Context()), httpLogSubsystem, "AppTrust API call", map[string]interface{}{
+		"method":           request.Method,
+		"url":              request.URL,
+		"attempt":          request.Attempt,
+		"status":           response.StatusCode(),
+		"latency_ms":       response.Time().Milliseconds(),
+		"request_headers":  l.headers(requestHeader(request)),
+		"request_body":     redactBody(requestBody(request)),
+		"response_headers": l.headers(response.Header()),
+		"response_body":    redactBody(response.Body()),
+	})
+	return nil
+}
+
+// logError runs once a request has failed for good. Failures with a response were already
+// logged by logResponse, so only transport errors are logged here.
+func (l *httpLogger) logError(request *resty.Request, err error) {
+	var responseErr *resty.ResponseError
+	if errors.As(err, &responseErr) {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"method":          request.Method,
+		"url":             request.URL,
+		"attempt":         request.Attempt,
+		"error":           err.Error(),
+		"request_headers": l.headers(requestHeader(request)),
+		"request_body":    redactBody(requestBody(request)),
+	}
+	if !request.Time.IsZero() {
+		fields["latency_ms"] = time.Since(request.Time).Milliseconds()
+	}
+	tflog.SubsystemTrace(l.context(request.Context()), httpLogSubsystem, "AppTrust API call failed", fields)
+}
+
+// requestHeader prefers the headers actually sent, which include the Authorization header set by resty.
+func requestHeader(request *resty.Request) http.Header {
+	if request.RawRequest != nil {
+		return request.RawRequest.Header
+	}
+	return request.Header
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		redacted[name] = strings.Join(values, ", ")
+	}
+	for _, name := range redactedHeaders {
+		if header.Get(name) != "" {
+			redacted[http.CanonicalHeaderKey(name)] = redactedValue
+		}
+	}
+	return redacted
+}
+
+func requestBody(request *resty.Request) []byte {
+	switch body := request.Body.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return body
+	case string:
+		return []byte(body)
+	default:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil
+		}
+		return encoded
+	}
+}
+
+// redactBody masks the credential fields of a JSON body. Other bodies are logged as they are.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if encoded, err := json.Marshal(redactJSON(decoded)); err == nil {
+			body = encoded
+		}
+	}
+
+	if len(body) > maxLoggedBodyBytes {
+		return string(body[:maxLoggedBodyBytes]) + "...(truncated)"
+	}
+	return string(body)
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactedBodyFields[strings.ToLower(key)] {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+// sensitiveValues returns the configured values of the provider attributes marked Sensitive.
+func (m AppTrustProviderModel) sensitiveValues() []string {
+	values := []string{m.AccessToken.ValueString(), m.ApiKey.ValueString()}
+	if m.ProxyAuth != nil {
+		values = append(values, m.ProxyAuth.Password.ValueString())
+	}
+	if m.TLS != nil {
+		values = append(values, m.TLS.ClientKey.ValueString())
+	}
+	return values
+}
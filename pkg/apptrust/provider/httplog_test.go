@@ -0,0 +1,143 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   map[string]string
+	}{
+		{
+			name:   "authorization",
+			header: http.Header{"Authorization": {"Bearer secret-token"}, "Accept": {"application/json"}},
+			want:   map[string]string{"Authorization": redactedValue, "Accept": "application/json"},
+		},
+		{
+			name:   "proxy authorization and API key",
+			header: http.Header{"Proxy-Authorization": {"Basic dXNlcjpwYXNz"}, "X-Jfrog-Art-Api": {"api-key"}},
+			want:   map[string]string{"Proxy-Authorization": redactedValue, "X-Jfrog-Art-Api": redactedValue},
+		},
+		{
+			name:   "cookies",
+			header: http.Header{"Cookie": {"session=abc"}, "Set-Cookie": {"session=abc; HttpOnly", "other=def"}},
+			want:   map[string]string{"Cookie": redactedValue, "Set-Cookie": redactedValue},
+		},
+		{
+			name:   "multiple values",
+			header: http.Header{"Accept-Encoding": {"gzip", "br"}},
+			want:   map[string]string{"Accept-Encoding": "gzip, br"},
+		},
+		{
+			name:   "empty",
+			header: http.Header{},
+			want:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactHeaders(tt.header)
+			if len(got) != len(tt.want) {
+				t.Fatalf("redactHeaders() = %v, want %v", got, tt.want)
+			}
+			for name, value := range tt.want {
+				if got[name] != value {
+					t.Errorf("redactHeaders()[%q] = %q, want %q", name, got[name], value)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", "", ""},
+		{"no credentials", `{"application_key":"app-1"}`, `{"application_key":"app-1"}`},
+		{"token exchange response", `{"access_token":"jfrog-token","token_type":"Bearer"}`, `{"access_token":"***","token_type":"Bearer"}`},
+		{"case-insensitive", `{"Password":"secret","API_KEY":"key"}`, `{"API_KEY":"***","Password":"***"}`},
+		{"nested", `{"user":{"name":"bob","credentials":{"password":"secret","token":"t"}}}`, `{"user":{"credentials":{"password":"***","token":"***"},"name":"bob"}}`},
+		{"array", `[{"refresh_token":"r"},{"id_token":"i","scope":"applied-permissions/user"}]`, `[{"refresh_token":"***"},{"id_token":"***","scope":"applied-permissions/user"}]`},
+		{"redacted object", `{"token":{"value":"secret"}}`, `{"token":"***"}`},
+		{"not JSON", `<html>Bad Gateway</html>`, `<html>Bad Gateway</html>`},
+		{"invalid JSON", `{"password":"secret"`, `{"password":"secret"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody(%s) = %s, want %s", tt.body, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		got := redactBody(bytes.Repeat([]byte("a"), maxLoggedBodyBytes+1))
+		if len(got) != maxLoggedBodyBytes+len("...(truncated)") || !strings.HasSuffix(got, "...(truncated)") {
+			t.Errorf("expected the body to be truncated, got %d bytes", len(got))
+		}
+	})
+}
+
+func TestHTTPLogger_masksSecrets(t *testing.T) {
+	t.Setenv("TF_LOG_PROVIDER_APPTRUST_HTTP", "TRACE")
+	const exchangedToken = "oidc-exchanged-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"token ` + exchangedToken + ` is expired"}]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	httpLog := &httpLogger{}
+	httpLog.addSecrets(exchangedToken, "")
+	restyClient := resty.New().SetBaseURL(server.URL).SetAuthToken(exchangedToken)
+	httpLog.install(restyClient)
+
+	_, err := restyClient.R().
+		SetContext(ctx).
+		SetHeader("X-Debug", exchangedToken).
+		SetBody(map[string]string{"description": "uses " + exchangedToken}).
+		Post("apptrust/api/v1/applications")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	logged := output.String()
+	if !strings.Contains(logged, "AppTrust API call") {
+		t.Fatalf("expected the call to be logged, got %q", logged)
+	}
+	if strings.Contains(logged, exchangedToken) {
+		t.Errorf("expected the exchanged token to be masked everywhere, got %s", logged)
+	}
+}
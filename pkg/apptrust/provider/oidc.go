@@ -0,0 +1,156 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	oidcTokenExchangeEndpoint = "access/api/v1/oidc/token"
+
+	tfcWorkloadIdentityTokenEnvVar  = "TFC_WORKLOAD_IDENTITY_TOKEN"
+	githubIDTokenRequestURLEnvVar   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	githubIDTokenRequestTokenEnvVar = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+)
+
+type oidcTokenExchangeRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectTokenType string `json:"subject_token_type"`
+	SubjectToken     string `json:"subject_token"`
+	ProviderName     string `json:"provider_name"`
+}
+
+type oidcTokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+}
+
+// oidcSettings returns the OIDC provider name and audience. The configuration block takes
+// precedence over the JFROG_OIDC_PROVIDER_NAME and JFROG_OIDC_AUDIENCE environment variables.
+func oidcSettings(config AppTrustProviderModel) (providerName, audience string) {
+	providerName = util.CheckEnvVars([]string{"JFROG_OIDC_PROVIDER_NAME"}, "")
+	if config.OIDCProviderName.ValueString() != "" {
+		providerName = config.OIDCProviderName.ValueString()
+	}
+	audience = util.CheckEnvVars([]string{"JFROG_OIDC_AUDIENCE"}, "")
+	if config.OIDCAudience.ValueString() != "" {
+		audience = config.OIDCAudience.ValueString()
+	}
+	return providerName, audience
+}
+
+// oidcTokenExchange reads the ID token issued by the CI platform (HCP Terraform / Terraform Enterprise
+// workload identity or GitHub Actions) and exchanges it for a JFrog access token using the named
+// OIDC integration. The client must not have authentication configured yet. The GitHub Actions ID
+// token is requested over the transport of the client, so the tls and proxy settings apply.
+func oidcTokenExchange(ctx context.Context, restyClient *resty.Client, providerName, tfcCredentialTagName, audience string) (string, error) {
+	idToken, source, err := ciIDToken(ctx, restyClient.GetClient().Transport, tfcCredentialTagName, audience)
+	if err != nil {
+		return "", err
+	}
+
+	tflog.Debug(ctx, "Exchanging CI ID token for JFrog access token", map[string]interface{}{
+		"oidc_provider_name": providerName,
+		"id_token_source":    source,
+	})
+
+	var result oidcTokenExchangeResponse
+	response, err := restyClient.R().
+		SetContext(ctx).
+		SetBody(oidcTokenExchangeRequest{
+			GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
+			SubjectTokenType: "urn:ietf:params:oauth:token-type:id_token",
+			SubjectToken:     idToken,
+			ProviderName:     providerName,
+		}).
+		SetResult(&result).
+		Post(oidcTokenExchangeEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("failed to send OIDC token exchange request: %w", err)
+	}
+	if response.IsError() {
+		return "", fmt.Errorf("OIDC token exchange with provider '%s' failed (Status: %d): %s", providerName, response.StatusCode(), response.String())
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("OIDC token exchange with provider '%s' returned an empty access token", providerName)
+	}
+
+	return result.AccessToken, nil
+}
+
+// ciIDToken returns the ID token of the current CI run and a short name of where it came from.
+// HCP Terraform workload identity takes precedence over GitHub Actions, whose ID token is requested
+// over transport.
+func ciIDToken(ctx context.Context, transport http.RoundTripper, tfcCredentialTagName, audience string) (string, string, error) {
+	tfcEnvVar := tfcWorkloadIdentityTokenEnvVar
+	if tfcCredentialTagName != "" {
+		tfcEnvVar = fmt.Sprintf("%s_%s", tfcWorkloadIdentityTokenEnvVar, tfcCredentialTagName)
+	}
+	if token := os.Getenv(tfcEnvVar); token != "" {
+		return token, tfcEnvVar, nil
+	}
+
+	requestURL := os.Getenv(githubIDTokenRequestURLEnvVar)
+	requestToken := os.Getenv(githubIDTokenRequestTokenEnvVar)
+	if requestURL != "" && requestToken != "" {
+		token, err := githubActionsIDToken(ctx, transport, requestURL, requestToken, audience)
+		if err != nil {
+			return "", "", err
+		}
+		return token, "GitHub Actions", nil
+	}
+
+	return "", "", fmt.Errorf("no CI ID token found: set %s (HCP Terraform workload identity) or run in GitHub Actions with the 'id-token: write' permission", tfcEnvVar)
+}
+
+// githubActionsIDToken requests an ID token from the GitHub Actions runtime for the given audience.
+// Only the transport of the provider client is shared, so neither its base URL nor its headers and
+// hooks reach GitHub.
+func githubActionsIDToken(ctx context.Context, transport http.RoundTripper, requestURL, requestToken, audience string) (string, error) {
+	var result struct {
+		Value string `json:"value"`
+	}
+
+	request := resty.NewWithClient(&http.Client{Transport: transport}).R().
+		SetContext(ctx).
+		SetAuthToken(requestToken).
+		SetResult(&result)
+	if audience != "" {
+		request.SetQueryParam("audience", audience)
+	}
+
+	response, err := request.Get(requestURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to request GitHub Actions ID token: %w", err)
+	}
+	if response.IsError() {
+		return "", fmt.Errorf("failed to request GitHub Actions ID token (Status: %d): %s", response.StatusCode(), response.String())
+	}
+	if result.Value == "" {
+		return "", fmt.Errorf("GitHub Actions returned an empty ID token")
+	}
+
+	return result.Value, nil
+}
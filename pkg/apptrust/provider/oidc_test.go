@@ -0,0 +1,215 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// unsetCIEnv clears the CI ID token environment variables for the duration of the test.
+func unsetCIEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{
+		tfcWorkloadIdentityTokenEnvVar,
+		tfcWorkloadIdentityTokenEnvVar + "_JFROG",
+		githubIDTokenRequestURLEnvVar,
+		githubIDTokenRequestTokenEnvVar,
+		"JFROG_OIDC_PROVIDER_NAME",
+		"JFROG_OIDC_AUDIENCE",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestOIDCSettings(t *testing.T) {
+	unsetCIEnv(t)
+
+	if name, audience := oidcSettings(AppTrustProviderModel{}); name != "" || audience != "" {
+		t.Errorf("expected no OIDC settings, got %q and %q", name, audience)
+	}
+
+	t.Setenv("JFROG_OIDC_PROVIDER_NAME", "env-provider")
+	t.Setenv("JFROG_OIDC_AUDIENCE", "env-audience")
+	if name, audience := oidcSettings(AppTrustProviderModel{}); name != "env-provider" || audience != "env-audience" {
+		t.Errorf("expected the environment settings, got %q and %q", name, audience)
+	}
+
+	config := AppTrustProviderModel{
+		OIDCProviderName: types.StringValue("config-provider"),
+		OIDCAudience:     types.StringValue("config-audience"),
+	}
+	if name, audience := oidcSettings(config); name != "config-provider" || audience != "config-audience" {
+		t.Errorf("expected the configuration to take precedence, got %q and %q", name, audience)
+	}
+}
+
+func githubIDTokenServer(t *testing.T, wantAudience string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if got := r.URL.Query().Get("audience"); got != wantAudience {
+			t.Errorf("expected audience %q, got %q", wantAudience, got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":"github-id-token"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCIIDToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("none", func(t *testing.T) {
+		unsetCIEnv(t)
+		if _, _, err := ciIDToken(ctx, http.DefaultTransport, "", ""); err == nil || !strings.Contains(err.Error(), tfcWorkloadIdentityTokenEnvVar) {
+			t.Errorf("expected an error naming %s, got %v", tfcWorkloadIdentityTokenEnvVar, err)
+		}
+	})
+
+	t.Run("HCP Terraform over GitHub Actions", func(t *testing.T) {
+		unsetCIEnv(t)
+		server := githubIDTokenServer(t, "")
+		t.Setenv(githubIDTokenRequestURLEnvVar, server.URL)
+		t.Setenv(githubIDTokenRequestTokenEnvVar, "request-token")
+		t.Setenv(tfcWorkloadIdentityTokenEnvVar, "tfc-id-token")
+
+		token, source, err := ciIDToken(ctx, http.DefaultTransport, "", "")
+		if err != nil || token != "tfc-id-token" || source != tfcWorkloadIdentityTokenEnvVar {
+			t.Errorf("expected the HCP Terraform token, got %q from %q (%v)", token, source, err)
+		}
+	})
+
+	t.Run("credential tag", func(t *testing.T) {
+		unsetCIEnv(t)
+		t.Setenv(tfcWorkloadIdentityTokenEnvVar, "default-id-token")
+		t.Setenv(tfcWorkloadIdentityTokenEnvVar+"_JFROG", "tagged-id-token")
+
+		token, _, err := ciIDToken(ctx, http.DefaultTransport, "JFROG", "")
+		if err != nil || token != "tagged-id-token" {
+			t.Errorf("expected the tagged token, got %q (%v)", token, err)
+		}
+	})
+
+	t.Run("GitHub Actions", func(t *testing.T) {
+		unsetCIEnv(t)
+		server := githubIDTokenServer(t, "jfrog")
+		t.Setenv(githubIDTokenRequestURLEnvVar, server.URL)
+		t.Setenv(githubIDTokenRequestTokenEnvVar, "request-token")
+
+		token, source, err := ciIDToken(ctx, http.DefaultTransport, "", "jfrog")
+		if err != nil || token != "github-id-token" || source != "GitHub Actions" {
+			t.Errorf("expected the GitHub Actions token, got %q from %q (%v)", token, source, err)
+		}
+	})
+}
+
+func TestOIDCTokenExchange(t *testing.T) {
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		status    int
+		body      string
+		wantToken string
+		wantErr   string
+	}{
+		"success":     {http.StatusOK, `{"access_token":"jfrog-access-token","token_type":"Bearer"}`, "jfrog-access-token", ""},
+		"error":       {http.StatusUnauthorized, `{"errors":[{"message":"invalid token"}]}`, "", "Status: 401"},
+		"empty token": {http.StatusOK, `{}`, "", "empty access token"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			unsetCIEnv(t)
+			t.Setenv(tfcWorkloadIdentityTokenEnvVar, "tfc-id-token")
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/"+oidcTokenExchangeEndpoint {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				var body oidcTokenExchangeRequest
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("failed to decode the request: %v", err)
+				}
+				if body.SubjectToken != "tfc-id-token" || body.ProviderName != "my-oidc" {
+					t.Errorf("unexpected exchange request %+v", body)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			token, err := oidcTokenExchange(ctx, resty.New().SetBaseURL(server.URL), "my-oidc", "", "")
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil || token != tc.wantToken {
+				t.Errorf("expected token %q, got %q (%v)", tc.wantToken, token, err)
+			}
+		})
+	}
+}
+
+func TestOIDCTokenExchange_githubActionsThroughProxy(t *testing.T) {
+	unsetCIEnv(t)
+	ctx := context.Background()
+
+	platform := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body oidcTokenExchangeRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.SubjectToken != "github-id-token" {
+			t.Errorf("unexpected exchange request %+v (%v)", body, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"jfrog-access-token"}`))
+	}))
+	defer platform.Close()
+	// The proxy answers the GitHub Actions ID token request, whose host does not resolve. Requests
+	// to the platform on localhost bypass the proxy.
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.Host)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":"github-id-token"}`))
+	}))
+	defer proxy.Close()
+
+	t.Setenv(githubIDTokenRequestURLEnvVar, "http://github-actions.invalid/token")
+	t.Setenv(githubIDTokenRequestTokenEnvVar, "request-token")
+
+	restyClient := resty.New().SetBaseURL(platform.URL)
+	if err := configureProxy(restyClient, proxy.URL, "", nil); err != nil {
+		t.Fatal(err)
+	}
+	token, err := oidcTokenExchange(ctx, restyClient, "my-oidc", "", "")
+	if err != nil || token != "jfrog-access-token" {
+		t.Fatalf("expected the exchanged token, got %q (%v)", token, err)
+	}
+	if len(proxied) != 1 || proxied[0] != "github-actions.invalid" {
+		t.Errorf("expected the ID token request to go through the proxy, got %v", proxied)
+	}
+}
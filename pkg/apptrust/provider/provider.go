@@ -16,20 +16,34 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	apptrust_action "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/action"
+	apptrust_client "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 	apptrust_datasource "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/datasource"
+	apptrust_ephemeral "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/ephemeral"
+	apptrust_function "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/function"
 	apptrust_resource "github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/resource"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/util"
@@ -46,15 +60,38 @@ const (
 )
 
 var _ provider.Provider = (*AppTrustProvider)(nil)
+var _ provider.ProviderWithFunctions = (*AppTrustProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*AppTrustProvider)(nil)
+var _ provider.ProviderWithActions = (*AppTrustProvider)(nil)
+var _ provider.ProviderWithListResources = (*AppTrustProvider)(nil)
 
 // AppTrustProvider is the provider implementation for AppTrust.
-type AppTrustProvider struct{}
+type AppTrustProvider struct {
+	// usage is the usage reporter of the last Configure call, nil when telemetry is disabled.
+	usage *apptrust.UsageReporter
+}
 
 // AppTrustProviderModel describes the provider data model.
 type AppTrustProviderModel struct {
-	Url         types.String `tfsdk:"url"`
-	AccessToken types.String `tfsdk:"access_token"`
-	ApiKey      types.String `tfsdk:"api_key"`
+	Url                    types.String    `tfsdk:"url"`
+	AccessToken            types.String    `tfsdk:"access_token"`
+	ApiKey                 types.String    `tfsdk:"api_key"`
+	OIDCProviderName       types.String    `tfsdk:"oidc_provider_name"`
+	TFCCredentialTagName   types.String    `tfsdk:"tfc_credential_tag_name"`
+	OIDCAudience           types.String    `tfsdk:"oidc_audience"`
+	Retry                  *RetryModel     `tfsdk:"retry"`
+	TLS                    *TLSModel       `tfsdk:"tls"`
+	SkipVersionCheck       types.Bool      `tfsdk:"skip_version_check"`
+	VersionCacheTTLSeconds types.Int64     `tfsdk:"version_cache_ttl_seconds"`
+	ProxyURL               types.String    `tfsdk:"proxy_url"`
+	NoProxy                types.String    `tfsdk:"no_proxy"`
+	ProxyAuth              *ProxyAuthModel `tfsdk:"proxy_auth"`
+	ExtraHeaders           types.Map       `tfsdk:"extra_headers"`
+	DefaultLabels          types.Map       `tfsdk:"default_labels"`
+	ProjectKey             types.String    `tfsdk:"project_key"`
+	DisableTelemetry       types.Bool      `tfsdk:"disable_telemetry"`
+	MaxConcurrentRequests  types.Int64     `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond      types.Int64     `tfsdk:"requests_per_second"`
 }
 
 func (p *AppTrustProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -86,6 +123,223 @@ func (p *AppTrustProvider) Schema(ctx context.Context, req provider.SchemaReques
 				Optional:           true,
 				Sensitive:          true,
 			},
+			"oidc_provider_name": schema.StringAttribute{
+				Description: "OIDC provider name. When set, the provider reads the ID token issued by the CI platform (HCP Terraform workload identity or GitHub Actions) " +
+					"and exchanges it for a short-lived JFrog access token. Can also be set with the `JFROG_OIDC_PROVIDER_NAME` environment variable. " +
+					"See [Configure an OIDC Integration](https://jfrog.com/help/r/jfrog-platform-administration-documentation/configure-an-oidc-integration) for more details.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tfc_credential_tag_name": schema.StringAttribute{
+				Description: "HCP Terraform workload identity token tag name. Use for generating multiple workload identity tokens. " +
+					"When set, the provider reads the `TFC_WORKLOAD_IDENTITY_TOKEN_<tag>` environment variable instead of `TFC_WORKLOAD_IDENTITY_TOKEN`. " +
+					"**Note:** this is case sensitive. See [Generating Multiple Tokens](https://developer.hashicorp.com/terraform/cloud-docs/workspaces/dynamic-provider-credentials/manual-generation#generating-multiple-tokens) for more details.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"oidc_audience": schema.StringAttribute{
+				Description: "Audience requested for the GitHub Actions ID token. Must match the audience configured in the JFrog OIDC integration. " +
+					"Can also be set with the `JFROG_OIDC_AUDIENCE` environment variable. Ignored for HCP Terraform, where the audience is set by `TFC_WORKLOAD_IDENTITY_AUDIENCE`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry settings for AppTrust API calls. GET, PATCH and DELETE requests that fail with a network error or status 429, 502, 503 or 504 are retried " +
+					"with exponential backoff, honouring the `Retry-After` response header. POST requests are never retried. Defaults apply when the block is omitted.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of attempts for a request, including the first one. Set to 1 to disable retries. Defaults to %d.", defaultRetryMaxAttempts),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 20),
+						},
+					},
+					"min_backoff_ms": schema.Int64Attribute{
+						Description: fmt.Sprintf("Minimum wait between attempts, in milliseconds. Defaults to %d.", defaultRetryMinBackoff.Milliseconds()),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"max_backoff_ms": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum wait between attempts when the server does not send `Retry-After`, in milliseconds. Defaults to %d.", defaultRetryMaxBackoff.Milliseconds()),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"jitter": schema.BoolAttribute{
+						Description: "Randomize the exponential backoff to spread out retries from parallel operations. Defaults to `true`.",
+						Optional:    true,
+					},
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests in flight at the same time, across all resources and data sources of this provider, " +
+					"regardless of Terraform `-parallelism`. Retries count as separate requests. Not limited when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Description: "Maximum number of API requests started per second, across all resources and data sources of this provider. " +
+					"Retries count as separate requests. Not limited when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_version_check": schema.BoolAttribute{
+				Description: "Skip detecting the Artifactory and Xray versions and checking them against the minimum versions required by AppTrust. " +
+					"Useful for `terraform validate` in offline CI. Can also be set with the `JFROG_SKIP_VERSION_CHECK` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"version_cache_ttl_seconds": schema.Int64Attribute{
+				Description: "Cache the detected Artifactory and Xray versions on disk (in the user cache directory, keyed by `url`) for this many seconds, " +
+					"so repeated runs do not probe the platform again. Defaults to `0` (no caching).",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"disable_telemetry": schema.BoolAttribute{
+				Description: "Do not send usage telemetry to the JFrog Platform. When telemetry is enabled, usage is recorded during the run " +
+					"and sent as a single report when Terraform stops the provider. Sending the report may delay the provider exit by up to 1 second; " +
+					"a report not sent by then is dropped. Can also be set with the `JFROG_DISABLE_TELEMETRY` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the HTTP(S) proxy used for all requests to the JFrog Platform, e.g. `http://proxy.example.com:3128`. " +
+					"When not set, the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are used.",
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.IsURLHttpOrHttps(),
+				},
+			},
+			"no_proxy": schema.StringAttribute{
+				Description: "Comma-separated list of hosts, domains (`.example.com`), IP addresses or CIDR ranges that bypass `proxy_url`. Same syntax as the `NO_PROXY` environment variable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+			},
+			"proxy_auth": schema.SingleNestedAttribute{
+				Description: "Credentials for an authenticating proxy, sent as `Proxy-Authorization` basic authentication.",
+				Optional:    true,
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "Proxy user name.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"password": schema.StringAttribute{
+						Description: "Proxy password.",
+						Required:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every request the provider issues, e.g. a routing header required by an API gateway. " +
+					"The `Authorization` header cannot be overridden.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthAtLeast(1),
+						stringvalidator.NoneOfCaseInsensitive("Authorization"),
+					),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				Description: "Default project key, used by `apptrust_application` and the `apptrust_applications` data source when they do not set `project_key`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_labels": schema.MapAttribute{
+				Description: "Labels added to every `apptrust_application` managed by this provider. " +
+					"Labels set in a resource's `labels` take precedence over a default label with the same key. " +
+					"The effective set is exposed in the resource's `labels_all` attribute.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthBetween(1, 255),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 255),
+					),
+				},
+			},
+			"tls": schema.SingleNestedAttribute{
+				Description: "TLS settings for connections to the JFrog Platform, e.g. a private certificate authority or mutual TLS.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"ca_cert_file": schema.StringAttribute{
+						Description: "Path to a PEM encoded CA bundle trusted in addition to the system certificate pool.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"ca_cert_pem": schema.StringAttribute{
+						Description: "PEM encoded CA certificates trusted in addition to the system certificate pool.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"client_cert": schema.StringAttribute{
+						Description: "PEM encoded client certificate for mutual TLS. Requires `client_key`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_key")),
+						},
+					},
+					"client_key": schema.StringAttribute{
+						Description: "PEM encoded private key of `client_cert`.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_cert")),
+						},
+					},
+					"server_name": schema.StringAttribute{
+						Description: "Server name used to verify the certificate presented by the platform, when it differs from the `url` host.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"min_version": schema.StringAttribute{
+						Description: "Minimum TLS version. Allowed values: `1.2`, `1.3`. Defaults to `1.2`.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("1.2", "1.3"),
+						},
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						Description: "Disable verification of the server certificate. **Insecure**, intended for testing only. " +
+							"The `JFROG_BYPASS_TLS_VERIFICATION=true` environment variable has the same effect.",
+						Optional: true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -127,26 +381,15 @@ func (p *AppTrustProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Configuration block values take precedence over environment variables
-	if config.AccessToken.ValueString() != "" {
-		accessToken = config.AccessToken.ValueString()
-	}
-
-	apiKey := config.ApiKey.ValueString()
-
-	if apiKey == "" && accessToken == "" {
-		resp.Diagnostics.AddError(
-			"Missing JFrog API key or Access Token",
-			"While configuring the provider, the API key or Access Token was not found in "+
-				"the environment variables or provider configuration attributes.",
-		)
-		return
-	}
+	httpLog := &httpLogger{}
+	httpLog.addSecrets(accessToken)
+	httpLog.addSecrets(config.sensitiveValues()...)
+	httpLog.install(restyClient)
 
-	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
+	tlsConfig, err := buildTLSConfig(config.TLS)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error adding Auth to Resty client",
+			"Invalid TLS configuration",
 			err.Error(),
 		)
 		return
@@ -155,94 +398,147 @@ func (p *AppTrustProvider) Configure(ctx context.Context, req provider.Configure
 	// Handle TLS verification bypass (for testing/development only)
 	bypassJFrogTLSVerification := os.Getenv("JFROG_BYPASS_TLS_VERIFICATION")
 	if strings.ToLower(bypassJFrogTLSVerification) == "true" {
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: true,
-		}
+		tlsConfig.InsecureSkipVerify = true
+	}
+	if tlsConfig.InsecureSkipVerify {
+		resp.Diagnostics.AddWarning(
+			"TLS certificate verification is disabled",
+			"The provider will not verify the certificate presented by the JFrog Platform. "+
+				"This exposes the access token to man-in-the-middle attacks and should only be used for testing. "+
+				"Configure tls.ca_cert_file or tls.ca_cert_pem to trust a private certificate authority instead.",
+		)
+	}
+	if config.TLS != nil || tlsConfig.InsecureSkipVerify {
 		restyClient.SetTLSClientConfig(tlsConfig)
 	}
 
-	artifactoryVersion, err := util.GetArtifactoryVersion(restyClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting Artifactory version",
-			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", err),
-		)
-		return
+	if proxyURL := config.ProxyURL.ValueString(); proxyURL != "" {
+		if err := configureProxy(restyClient, proxyURL, config.NoProxy.ValueString(), config.ProxyAuth); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid proxy configuration",
+				err.Error(),
+			)
+			return
+		}
 	}
 
-	// Check Artifactory version compatibility
-	minArtifactoryVersion, err := version.NewVersion(MinArtifactoryVersion)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid minimum Artifactory version",
-			fmt.Sprintf("Failed to parse minimum required Artifactory version: %v", err),
-		)
-		return
+	if !config.ExtraHeaders.IsNull() {
+		extraHeaders := make(map[string]string)
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		restyClient.SetHeaders(extraHeaders)
 	}
 
-	currentArtifactoryVersion, err := version.NewVersion(artifactoryVersion)
-	if err != nil {
-		resp.Diagnostics.AddWarning(
-			"Unable to parse Artifactory version",
-			fmt.Sprintf("Unable to parse Artifactory version '%s'. Version compatibility check skipped. %v", artifactoryVersion, err),
-		)
-	} else if currentArtifactoryVersion.LessThan(minArtifactoryVersion) {
-		resp.Diagnostics.AddError(
-			"Incompatible Artifactory version",
-			fmt.Sprintf("AppTrust requires Artifactory version %s or higher. Current version: %s", MinArtifactoryVersion, artifactoryVersion),
-		)
-		return
+	newRetryPolicy(config.Retry).apply(restyClient)
+
+	// The limiter wraps the transport, so it is installed after the TLS and proxy settings that
+	// require the underlying *http.Transport.
+	if !config.MaxConcurrentRequests.IsNull() || !config.RequestsPerSecond.IsNull() {
+		limiter := apptrust_client.NewLimiter(int(config.MaxConcurrentRequests.ValueInt64()), int(config.RequestsPerSecond.ValueInt64()))
+		restyClient.SetTransport(limiter.Transport(restyClient.GetClient().Transport))
 	}
 
-	// Check Xray version compatibility
-	xrayVersion, err := util.GetXrayVersion(restyClient)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error getting Xray version",
-			fmt.Sprintf("Failed to get Xray version. AppTrust requires Xray to be installed and accessible. %v", err),
-		)
-		return
+	// An access_token set in the configuration block takes precedence over the environment and
+	// OIDC. Otherwise the CI-issued ID token is exchanged for an access token, which replaces a
+	// token from the environment.
+	if config.AccessToken.ValueString() != "" {
+		accessToken = config.AccessToken.ValueString()
+	} else if oidcProviderName, oidcAudience := oidcSettings(config); oidcProviderName != "" {
+		oidcAccessToken, err := oidcTokenExchange(ctx, restyClient, oidcProviderName, config.TFCCredentialTagName.ValueString(), oidcAudience)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed OIDC ID token exchange",
+				err.Error(),
+			)
+			return
+		}
+		accessToken = oidcAccessToken
+		httpLog.addSecrets(accessToken)
 	}
 
-	minXrayVersion, err := version.NewVersion(MinXrayVersion)
-	if err != nil {
+	apiKey := config.ApiKey.ValueString()
+
+	if apiKey == "" && accessToken == "" {
 		resp.Diagnostics.AddError(
-			"Invalid minimum Xray version",
-			fmt.Sprintf("Failed to parse minimum required Xray version: %v", err),
+			"Missing JFrog API key or Access Token",
+			"While configuring the provider, the API key or Access Token was not found in "+
+				"the environment variables or provider configuration attributes, and no OIDC provider was configured.",
 		)
 		return
 	}
 
-	currentXrayVersion, err := version.NewVersion(xrayVersion)
+	restyClient, err = client.AddAuth(restyClient, apiKey, accessToken)
 	if err != nil {
-		resp.Diagnostics.AddWarning(
-			"Unable to parse Xray version",
-			fmt.Sprintf("Unable to parse Xray version '%s'. Version compatibility check skipped. %v", xrayVersion, err),
-		)
-	} else if currentXrayVersion.LessThan(minXrayVersion) {
 		resp.Diagnostics.AddError(
-			"Incompatible Xray version",
-			fmt.Sprintf("AppTrust requires Xray version %s or higher. Current version: %s", MinXrayVersion, xrayVersion),
+			"Error adding Auth to Resty client",
+			err.Error(),
 		)
 		return
 	}
 
+	skipVersionCheck := strings.ToLower(util.CheckEnvVars([]string{"JFROG_SKIP_VERSION_CHECK"}, "")) == "true"
+	if !config.SkipVersionCheck.IsNull() {
+		skipVersionCheck = config.SkipVersionCheck.ValueBool()
+	}
+
+	var versions platformVersions
+	if skipVersionCheck {
+		tflog.Info(ctx, "Skipping Artifactory and Xray version check")
+	} else {
+		cacheTTL := time.Duration(config.VersionCacheTTLSeconds.ValueInt64()) * time.Second
+		var diags diag.Diagnostics
+		versions, diags = detectPlatformVersions(ctx, restyClient, url, cacheTTL)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Note: AppTrust license validation is handled by the API itself.
 	// If AppTrust is not licensed or available, API calls will return appropriate errors.
 
-	// Send usage telemetry (async)
-	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
-	go util.SendUsage(ctx, restyClient.R(), productId, featureUsage)
+	disableTelemetry := strings.ToLower(util.CheckEnvVars([]string{"JFROG_DISABLE_TELEMETRY"}, "")) == "true"
+	if !config.DisableTelemetry.IsNull() {
+		disableTelemetry = config.DisableTelemetry.ValueBool()
+	}
+
+	var usage *apptrust.UsageReporter
+	if disableTelemetry {
+		tflog.Info(ctx, "Usage telemetry is disabled")
+	} else {
+		usage = apptrust.NewUsageReporter(restyClient, productId)
+		usage.Record(fmt.Sprintf("Terraform/%s", req.TerraformVersion))
+	}
+	p.setUsageReporter(ctx, usage)
+
+	defaultLabels := make(map[string]string)
+	if !config.DefaultLabels.IsNull() {
+		resp.Diagnostics.Append(config.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	meta := util.ProviderMetadata{
-		Client:             restyClient,
-		ProductId:          productId,
-		ArtifactoryVersion: artifactoryVersion,
-		XrayVersion:        xrayVersion,
+	meta := apptrust.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{
+			Client:             restyClient,
+			ProductId:          productId,
+			ArtifactoryVersion: versions.Artifactory,
+			XrayVersion:        versions.Xray,
+		},
+		DefaultLabels: defaultLabels,
+		ProjectKey:    config.ProjectKey.ValueString(),
+		Usage:         usage,
+		Capabilities:  apptrust.NewCapabilities(versions.Artifactory),
 	}
 
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+	resp.ActionData = meta
+	resp.ListResourceData = meta
 }
 
 // Resources returns the list of resources supported by this provider.
@@ -257,6 +553,16 @@ func (p *AppTrustProvider) Resources(ctx context.Context) []func() resource.Reso
 	}
 }
 
+// ListResources returns the list of list resources supported by this provider, used by
+// `terraform query`.
+func (p *AppTrustProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		apptrust_resource.NewApplicationListResource,
+		apptrust_resource.NewApplicationVersionListResource,
+		apptrust_resource.NewBoundPackageListResource,
+	}
+}
+
 // DataSources returns the list of data sources supported by this provider.
 func (p *AppTrustProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
@@ -269,3 +575,31 @@ func (p *AppTrustProvider) DataSources(ctx context.Context) []func() datasource.
 		apptrust_datasource.NewBoundPackageVersionsDataSource,
 	}
 }
+
+// EphemeralResources returns the list of ephemeral resources supported by this provider.
+func (p *AppTrustProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		apptrust_ephemeral.NewApplicationAccessTokenEphemeralResource,
+	}
+}
+
+// Actions returns the list of actions supported by this provider.
+func (p *AppTrustProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		apptrust_action.NewPromoteAction,
+		apptrust_action.NewReleaseAction,
+		apptrust_action.NewRollbackAction,
+	}
+}
+
+// Functions returns the list of provider-defined functions supported by this provider.
+func (p *AppTrustProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		apptrust_function.NewParseVersionIDFunction,
+		apptrust_function.NewParsePromotionIDFunction,
+		apptrust_function.NewParseBoundPackageIDFunction,
+		apptrust_function.NewBoundPackageIDFunction,
+		apptrust_function.NewSemverCompareFunction,
+		apptrust_function.NewSemverNextFunction,
+	}
+}
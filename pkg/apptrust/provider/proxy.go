@@ -0,0 +1,59 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyAuthModel describes the provider `proxy_auth` block.
+type ProxyAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// configureProxy routes every request through proxyURL, except for hosts matched by noProxy
+// (same syntax as the NO_PROXY environment variable). Credentials from proxy_auth replace any
+// user info in proxyURL and are sent as Proxy-Authorization basic auth.
+func configureProxy(restyClient *resty.Client, proxyURL, noProxy string, auth *ProxyAuthModel) error {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("proxy_url '%s' is not a valid URL", proxyURL)
+	}
+	if auth != nil {
+		parsed.User = url.UserPassword(auth.Username.ValueString(), auth.Password.ValueString())
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  parsed.String(),
+		HTTPSProxy: parsed.String(),
+		NoProxy:    noProxy,
+	}).ProxyFunc()
+
+	transport, err := restyClient.Transport()
+	if err != nil {
+		return err
+	}
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+	return nil
+}
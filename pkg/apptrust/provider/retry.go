@@ -0,0 +1,177 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Retry defaults used when the provider `retry` block (or one of its attributes) is not set.
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+
+	// maxRetryAfter caps a server supplied Retry-After so a misbehaving proxy cannot stall a run indefinitely.
+	maxRetryAfter = 5 * time.Minute
+)
+
+// Only requests that are safe to send again are retried. POST calls (create, promote, release, rollback,
+// bind) are never retried so a request that reached the server is not applied twice.
+var retryableMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+}
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// RetryModel describes the provider `retry` block.
+type RetryModel struct {
+	MaxAttempts  types.Int64 `tfsdk:"max_attempts"`
+	MinBackoffMs types.Int64 `tfsdk:"min_backoff_ms"`
+	MaxBackoffMs types.Int64 `tfsdk:"max_backoff_ms"`
+	Jitter       types.Bool  `tfsdk:"jitter"`
+}
+
+type retryPolicy struct {
+	maxAttempts int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	jitter      bool
+}
+
+func newRetryPolicy(m *RetryModel) retryPolicy {
+	policy := retryPolicy{
+		maxAttempts: defaultRetryMaxAttempts,
+		minBackoff:  defaultRetryMinBackoff,
+		maxBackoff:  defaultRetryMaxBackoff,
+		jitter:      true,
+	}
+	if m == nil {
+		return policy
+	}
+	if !m.MaxAttempts.IsNull() && !m.MaxAttempts.IsUnknown() {
+		policy.maxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+	if !m.MinBackoffMs.IsNull() && !m.MinBackoffMs.IsUnknown() {
+		policy.minBackoff = time.Duration(m.MinBackoffMs.ValueInt64()) * time.Millisecond
+	}
+	if !m.MaxBackoffMs.IsNull() && !m.MaxBackoffMs.IsUnknown() {
+		policy.maxBackoff = time.Duration(m.MaxBackoffMs.ValueInt64()) * time.Millisecond
+	}
+	if !m.Jitter.IsNull() && !m.Jitter.IsUnknown() {
+		policy.jitter = m.Jitter.ValueBool()
+	}
+	if policy.maxBackoff < policy.minBackoff {
+		policy.maxBackoff = policy.minBackoff
+	}
+	return policy
+}
+
+// apply replaces any retry behaviour set up by client.Build with the provider policy.
+func (p retryPolicy) apply(restyClient *resty.Client) {
+	restyClient.
+		SetRetryCount(p.maxAttempts - 1).
+		SetRetryWaitTime(p.minBackoff).
+		SetRetryMaxWaitTime(maxRetryAfter).
+		SetRetryAfter(p.waitTime)
+	restyClient.RetryConditions = []resty.RetryConditionFunc{shouldRetry}
+	restyClient.RetryHooks = []resty.OnRetryFunc{logRetry}
+}
+
+func shouldRetry(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil {
+		return false
+	}
+	if !retryableMethods[strings.ToUpper(response.Request.Method)] {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return retryableStatusCodes[response.StatusCode()]
+}
+
+func logRetry(response *resty.Response, err error) {
+	if response == nil || response.Request == nil {
+		return
+	}
+	fields := map[string]interface{}{
+		"method":  response.Request.Method,
+		"url":     response.Request.URL,
+		"attempt": response.Request.Attempt,
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = response.StatusCode()
+	}
+	tflog.Warn(response.Request.Context(), "Retrying AppTrust API request", fields)
+}
+
+// waitTime honours a Retry-After header (seconds or HTTP date) and otherwise returns a capped
+// exponential backoff, optionally with full jitter. It never returns 0 so resty does not fall back
+// to its own jittered algorithm, which is bounded by the Retry-After cap rather than max_backoff_ms.
+func (p retryPolicy) waitTime(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response != nil {
+		if wait, ok := parseRetryAfter(response.Header().Get("Retry-After")); ok {
+			return min(max(wait, p.minBackoff), maxRetryAfter), nil
+		}
+	}
+
+	attempt := 1
+	if response != nil && response.Request != nil && response.Request.Attempt > 0 {
+		attempt = response.Request.Attempt
+	}
+
+	backoff := math.Min(float64(p.maxBackoff), float64(p.minBackoff)*math.Exp2(float64(attempt-1)))
+	wait := time.Duration(backoff)
+	if p.jitter && wait > p.minBackoff {
+		wait = p.minBackoff + time.Duration(rand.Int63n(int64(wait-p.minBackoff)+1))
+	}
+	return max(wait, time.Millisecond), nil
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,186 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRetryResponse(method string, attempt, statusCode int, retryAfter string) *resty.Response {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &resty.Response{
+		Request:     &resty.Request{Method: method, Attempt: attempt},
+		RawResponse: &http.Response{StatusCode: statusCode, Header: header},
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"empty", "", 0, false},
+		{"seconds", "7", 7 * time.Second, true},
+		{"zero", "0", 0, true},
+		{"negative", "-1", 0, false},
+		{"past date", "Sun, 06 Nov 1994 08:49:37 GMT", 0, true},
+		{"invalid", "soon", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseRetryAfter(%q) = %s, %t, want %s, %t", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		got, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		if !ok || got <= 50*time.Second || got > time.Minute {
+			t.Errorf("expected about a minute, got %s, %t", got, ok)
+		}
+	})
+}
+
+func TestRetryPolicy_waitTime(t *testing.T) {
+	policy := newRetryPolicy(&RetryModel{
+		MinBackoffMs: types.Int64Value(100),
+		MaxBackoffMs: types.Int64Value(1000),
+		Jitter:       types.BoolValue(false),
+	})
+
+	tests := []struct {
+		name     string
+		response *resty.Response
+		want     time.Duration
+	}{
+		{"first attempt", testRetryResponse(http.MethodGet, 1, http.StatusServiceUnavailable, ""), 100 * time.Millisecond},
+		{"exponential", testRetryResponse(http.MethodGet, 3, http.StatusServiceUnavailable, ""), 400 * time.Millisecond},
+		{"capped at max_backoff_ms", testRetryResponse(http.MethodGet, 10, http.StatusServiceUnavailable, ""), time.Second},
+		{"Retry-After seconds", testRetryResponse(http.MethodGet, 1, http.StatusTooManyRequests, "3"), 3 * time.Second},
+		{"Retry-After below min_backoff_ms", testRetryResponse(http.MethodGet, 1, http.StatusTooManyRequests, "0"), 100 * time.Millisecond},
+		{"Retry-After capped", testRetryResponse(http.MethodGet, 1, http.StatusTooManyRequests, "3600"), maxRetryAfter},
+		{"no response", nil, 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.waitTime(nil, tt.response)
+			if err != nil || got != tt.want {
+				t.Errorf("waitTime() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+
+	t.Run("jitter", func(t *testing.T) {
+		policy := newRetryPolicy(&RetryModel{
+			MinBackoffMs: types.Int64Value(100),
+			MaxBackoffMs: types.Int64Value(1000),
+		})
+		for range 100 {
+			got, _ := policy.waitTime(nil, testRetryResponse(http.MethodGet, 5, http.StatusBadGateway, ""))
+			if got < 100*time.Millisecond || got > time.Second {
+				t.Fatalf("expected a wait between min_backoff_ms and max_backoff_ms, got %s", got)
+			}
+		}
+	})
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{"GET 429", http.MethodGet, http.StatusTooManyRequests, nil, true},
+		{"GET 502", http.MethodGet, http.StatusBadGateway, nil, true},
+		{"GET 503", http.MethodGet, http.StatusServiceUnavailable, nil, true},
+		{"DELETE 504", http.MethodDelete, http.StatusGatewayTimeout, nil, true},
+		{"PATCH network error", http.MethodPatch, 0, errors.New("connection reset"), true},
+		{"GET 200", http.MethodGet, http.StatusOK, nil, false},
+		{"GET 400", http.MethodGet, http.StatusBadRequest, nil, false},
+		{"GET 404", http.MethodGet, http.StatusNotFound, nil, false},
+		{"GET 500", http.MethodGet, http.StatusInternalServerError, nil, false},
+		{"POST 503", http.MethodPost, http.StatusServiceUnavailable, nil, false},
+		{"POST network error", http.MethodPost, 0, errors.New("connection reset"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldRetry(testRetryResponse(tt.method, 1, tt.statusCode, ""), tt.err); got != tt.want {
+				t.Errorf("shouldRetry() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	if shouldRetry(nil, errors.New("no response")) {
+		t.Error("expected no retry without a response")
+	}
+}
+
+func TestRetryPolicy_apply(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method       string
+		status       int
+		wantRequests int32
+		wantStatus   int
+	}{
+		"GET retried until success": {http.MethodGet, http.StatusServiceUnavailable, 3, http.StatusOK},
+		"POST not retried":          {http.MethodPost, http.StatusServiceUnavailable, 1, http.StatusServiceUnavailable},
+		"GET 400 not retried":       {http.MethodGet, http.StatusBadRequest, 1, http.StatusBadRequest},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 3 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(tc.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			restyClient := resty.New().SetBaseURL(server.URL)
+			newRetryPolicy(&RetryModel{
+				MaxAttempts:  types.Int64Value(5),
+				MinBackoffMs: types.Int64Value(1),
+				MaxBackoffMs: types.Int64Value(5),
+			}).apply(restyClient)
+
+			response, err := restyClient.R().Execute(tc.method, "/")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests.Load() != tc.wantRequests || response.StatusCode() != tc.wantStatus {
+				t.Errorf("expected %d requests and status %d, got %d and %d", tc.wantRequests, tc.wantStatus, requests.Load(), response.StatusCode())
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var tlsMinVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSModel describes the provider `tls` block.
+type TLSModel struct {
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	ServerName         types.String `tfsdk:"server_name"`
+	MinVersion         types.String `tfsdk:"min_version"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// buildTLSConfig returns the client TLS configuration for the `tls` block. Custom CA certificates are
+// added to the system pool so public endpoints (e.g. a proxy or SaaS redirect) keep working.
+func buildTLSConfig(m *TLSModel) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if m == nil {
+		return tlsConfig, nil
+	}
+
+	if !m.MinVersion.IsNull() && m.MinVersion.ValueString() != "" {
+		minVersion, ok := tlsMinVersions[m.MinVersion.ValueString()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported min_version '%s'", m.MinVersion.ValueString())
+		}
+		tlsConfig.MinVersion = minVersion
+	}
+
+	if m.CACertFile.ValueString() != "" || m.CACertPEM.ValueString() != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if file := m.CACertFile.ValueString(); file != "" {
+			pem, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+			}
+			if !rootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("ca_cert_file '%s' does not contain any PEM encoded certificate", file)
+			}
+		}
+		if pem := m.CACertPEM.ValueString(); pem != "" {
+			if !rootCAs.AppendCertsFromPEM([]byte(pem)) {
+				return nil, fmt.Errorf("ca_cert_pem does not contain any PEM encoded certificate")
+			}
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if m.ClientCert.ValueString() != "" || m.ClientKey.ValueString() != "" {
+		certificate, err := tls.X509KeyPair([]byte(m.ClientCert.ValueString()), []byte(m.ClientKey.ValueString()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client_cert/client_key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	tlsConfig.ServerName = m.ServerName.ValueString()
+	tlsConfig.InsecureSkipVerify = m.InsecureSkipVerify.ValueBool()
+
+	return tlsConfig, nil
+}
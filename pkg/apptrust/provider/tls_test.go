@@ -0,0 +1,175 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serverCertPEM returns the PEM encoded certificate of a TLS test server.
+func serverCertPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// testClientCertificate returns a self-signed client certificate and key, PEM encoded.
+func testClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func tlsGet(tlsConfig *tls.Config, url string) error {
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	response, err := httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+func TestBuildTLSConfig_defaults(t *testing.T) {
+	tlsConfig, err := buildTLSConfig(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig.MinVersion != tls.VersionTLS12 || tlsConfig.InsecureSkipVerify || tlsConfig.RootCAs != nil {
+		t.Errorf("unexpected default TLS configuration %+v", tlsConfig)
+	}
+
+	tlsConfig, err = buildTLSConfig(&TLSModel{MinVersion: types.StringValue("1.3")})
+	if err != nil || tlsConfig.MinVersion != tls.VersionTLS13 {
+		t.Errorf("expected TLS 1.3, got %+v (%v)", tlsConfig, err)
+	}
+}
+
+func TestBuildTLSConfig_errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		model   TLSModel
+		wantErr string
+	}{
+		"min_version":        {TLSModel{MinVersion: types.StringValue("1.1")}, "unsupported min_version"},
+		"ca_cert_pem":        {TLSModel{CACertPEM: types.StringValue("not a certificate")}, "ca_cert_pem does not contain"},
+		"missing file":       {TLSModel{CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))}, "failed to read ca_cert_file"},
+		"client certificate": {TLSModel{ClientCert: types.StringValue("cert"), ClientKey: types.StringValue("key")}, "failed to load client_cert/client_key"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := buildTLSConfig(&tc.model); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBuildTLSConfig_customCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tlsConfig, err := buildTLSConfig(&TLSModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tlsGet(tlsConfig, server.URL); err == nil {
+		t.Error("expected the test server certificate to be untrusted without a custom CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(serverCertPEM(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for name, model := range map[string]TLSModel{
+		"ca_cert_pem":  {CACertPEM: types.StringValue(serverCertPEM(server))},
+		"ca_cert_file": {CACertFile: types.StringValue(caFile)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tlsConfig, err := buildTLSConfig(&model)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := tlsGet(tlsConfig, server.URL); err != nil {
+				t.Errorf("expected the custom CA to be trusted: %v", err)
+			}
+		})
+	}
+}
+
+func TestBuildTLSConfig_clientCertificate(t *testing.T) {
+	clientCert, clientKey := testClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM([]byte(clientCert))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	withoutClientCert, err := buildTLSConfig(&TLSModel{CACertPEM: types.StringValue(serverCertPEM(server))})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tlsGet(withoutClientCert, server.URL); err == nil {
+		t.Error("expected the server to require a client certificate")
+	}
+
+	tlsConfig, err := buildTLSConfig(&TLSModel{
+		CACertPEM:  types.StringValue(serverCertPEM(server)),
+		ClientCert: types.StringValue(clientCert),
+		ClientKey:  types.StringValue(clientKey),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tlsGet(tlsConfig, server.URL); err != nil {
+		t.Errorf("expected mutual TLS to succeed: %v", err)
+	}
+}
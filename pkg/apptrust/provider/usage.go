@@ -0,0 +1,82 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+// usageFlushTimeout bounds how long FlushUsage may delay the provider process exit. go-plugin
+// kills the plugin 2 seconds after asking it to shut down, so the flush must end well before.
+const usageFlushTimeout = time.Second
+
+var (
+	usageReportersMu sync.Mutex
+	usageReporters   = map[*apptrust.UsageReporter]struct{}{}
+)
+
+// setUsageReporter makes reporter the usage reporter of the provider instance, flushed by
+// FlushUsage. Every configured provider instance (one per provider block or alias) has its own,
+// as each may target a different platform. The reporter of a previous Configure call of the same
+// instance is flushed in the background instead of piling up; reporter may be nil when
+// telemetry is disabled.
+func (p *AppTrustProvider) setUsageReporter(ctx context.Context, reporter *apptrust.UsageReporter) {
+	usageReportersMu.Lock()
+	previous := p.usage
+	delete(usageReporters, previous)
+	if reporter != nil {
+		usageReporters[reporter] = struct{}{}
+	}
+	p.usage = reporter
+	usageReportersMu.Unlock()
+
+	if previous != nil {
+		go flushUsageReporters(context.WithoutCancel(ctx), previous)
+	}
+}
+
+// FlushUsage sends the usage aggregated by all configured provider instances. It is called once
+// the provider server stops, at the end of the Terraform run, and gives up after
+// usageFlushTimeout.
+func FlushUsage(ctx context.Context) {
+	usageReportersMu.Lock()
+	reporters := make([]*apptrust.UsageReporter, 0, len(usageReporters))
+	for reporter := range usageReporters {
+		reporters = append(reporters, reporter)
+	}
+	clear(usageReporters)
+	usageReportersMu.Unlock()
+
+	flushUsageReporters(ctx, reporters...)
+}
+
+func flushUsageReporters(ctx context.Context, reporters ...*apptrust.UsageReporter) {
+	ctx, cancel := context.WithTimeout(ctx, usageFlushTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, reporter := range reporters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reporter.Flush(ctx)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,74 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+func TestSetUsageReporter(t *testing.T) {
+	var reports atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reports.Add(1)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	newReporter := func() *apptrust.UsageReporter {
+		reporter := apptrust.NewUsageReporter(resty.New().SetBaseURL(server.URL), productId)
+		reporter.Record("Terraform/1.14.0")
+		return reporter
+	}
+
+	first, second := &AppTrustProvider{}, &AppTrustProvider{}
+	first.setUsageReporter(ctx, newReporter())
+	second.setUsageReporter(ctx, newReporter())
+	// Configuring the first instance again flushes its previous reporter instead of keeping it.
+	first.setUsageReporter(ctx, newReporter())
+
+	usageReportersMu.Lock()
+	registered := len(usageReporters)
+	usageReportersMu.Unlock()
+	if registered != 2 {
+		t.Fatalf("expected one reporter per provider instance, got %d", registered)
+	}
+
+	FlushUsage(ctx)
+	deadline := time.Now().Add(time.Second)
+	for reports.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if reports.Load() != 3 {
+		t.Errorf("expected every reporter to be flushed once, got %d reports", reports.Load())
+	}
+	if len(usageReporters) != 0 {
+		t.Errorf("expected FlushUsage to unregister the reporters, got %d", len(usageReporters))
+	}
+
+	// Disabling telemetry drops the reporter of the instance.
+	first.setUsageReporter(ctx, newReporter())
+	first.setUsageReporter(ctx, nil)
+	if len(usageReporters) != 0 || first.usage != nil {
+		t.Errorf("expected no reporter with telemetry disabled, got %d", len(usageReporters))
+	}
+}
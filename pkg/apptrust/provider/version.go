@@ -0,0 +1,251 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const versionCacheFileName = "platform-versions.json"
+
+// platformVersions holds the Artifactory and Xray versions detected during Configure.
+type platformVersions struct {
+	Artifactory string    `json:"artifactory_version"`
+	Xray        string    `json:"xray_version"`
+	DetectedAt  time.Time `json:"detected_at"`
+}
+
+// detectPlatformVersions returns the platform versions from the on-disk cache when a fresh entry
+// exists for the URL, and otherwise probes Artifactory and Xray concurrently. The detected versions
+// are checked against the minimum versions required by AppTrust before being cached.
+func detectPlatformVersions(ctx context.Context, restyClient *resty.Client, url string, cacheTTL time.Duration) (platformVersions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if cacheTTL > 0 {
+		if versions, ok := readVersionCache(ctx, url, cacheTTL); ok {
+			tflog.Debug(ctx, "Using cached platform versions", map[string]interface{}{
+				"url":                 url,
+				"artifactory_version": versions.Artifactory,
+				"xray_version":        versions.Xray,
+				"detected_at":         versions.DetectedAt,
+			})
+			return versions, diags
+		}
+	}
+
+	var (
+		versions                platformVersions
+		artifactoryErr, xrayErr error
+		wg                      sync.WaitGroup
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		versions.Artifactory, artifactoryErr = util.GetArtifactoryVersion(restyClient)
+	}()
+	go func() {
+		defer wg.Done()
+		versions.Xray, xrayErr = util.GetXrayVersion(restyClient)
+	}()
+	wg.Wait()
+
+	if artifactoryErr != nil {
+		diags.AddError(
+			"Error getting Artifactory version",
+			fmt.Sprintf("The provider functionality might be affected by the absence of Artifactory version in the context. %v", artifactoryErr),
+		)
+	}
+	if xrayErr != nil {
+		diags.AddError(
+			"Error getting Xray version",
+			fmt.Sprintf("Failed to get Xray version. AppTrust requires Xray to be installed and accessible. "+
+				"Set skip_version_check to configure the provider without contacting Xray. %v", xrayErr),
+		)
+	}
+	if diags.HasError() {
+		return versions, diags
+	}
+
+	diags.Append(checkPlatformVersions(versions)...)
+	if diags.HasError() {
+		return versions, diags
+	}
+
+	if cacheTTL > 0 {
+		versions.DetectedAt = time.Now()
+		writeVersionCache(ctx, url, versions)
+	}
+
+	return versions, diags
+}
+
+// checkPlatformVersions verifies the detected versions meet MinArtifactoryVersion and MinXrayVersion.
+// Versions that cannot be parsed only produce a warning.
+func checkPlatformVersions(versions platformVersions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Check Artifactory version compatibility
+	minArtifactoryVersion, err := version.NewVersion(MinArtifactoryVersion)
+	if err != nil {
+		diags.AddError(
+			"Invalid minimum Artifactory version",
+			fmt.Sprintf("Failed to parse minimum required Artifactory version: %v", err),
+		)
+		return diags
+	}
+
+	currentArtifactoryVersion, err := version.NewVersion(versions.Artifactory)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to parse Artifactory version",
+			fmt.Sprintf("Unable to parse Artifactory version '%s'. Version compatibility check skipped. %v", versions.Artifactory, err),
+		)
+	} else if currentArtifactoryVersion.LessThan(minArtifactoryVersion) {
+		diags.AddError(
+			"Incompatible Artifactory version",
+			fmt.Sprintf("AppTrust requires Artifactory version %s or higher. Current version: %s", MinArtifactoryVersion, versions.Artifactory),
+		)
+		return diags
+	}
+
+	// Check Xray version compatibility
+	minXrayVersion, err := version.NewVersion(MinXrayVersion)
+	if err != nil {
+		diags.AddError(
+			"Invalid minimum Xray version",
+			fmt.Sprintf("Failed to parse minimum required Xray version: %v", err),
+		)
+		return diags
+	}
+
+	currentXrayVersion, err := version.NewVersion(versions.Xray)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to parse Xray version",
+			fmt.Sprintf("Unable to parse Xray version '%s'. Version compatibility check skipped. %v", versions.Xray, err),
+		)
+	} else if currentXrayVersion.LessThan(minXrayVersion) {
+		diags.AddError(
+			"Incompatible Xray version",
+			fmt.Sprintf("AppTrust requires Xray version %s or higher. Current version: %s", MinXrayVersion, versions.Xray),
+		)
+	}
+
+	return diags
+}
+
+func versionCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "terraform-provider-apptrust", versionCacheFileName), nil
+}
+
+func versionCacheKey(url string) string {
+	return strings.TrimSuffix(strings.ToLower(url), "/")
+}
+
+func loadVersionCache() (map[string]platformVersions, string, error) {
+	cachePath, err := versionCachePath()
+	if err != nil {
+		return nil, "", err
+	}
+
+	entries := map[string]platformVersions{}
+	content, err := os.ReadFile(cachePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, cachePath, nil
+		}
+		return nil, cachePath, err
+	}
+	if err := json.Unmarshal(content, &entries); err != nil {
+		// A corrupt cache is discarded and rewritten on the next successful probe.
+		return map[string]platformVersions{}, cachePath, nil
+	}
+	return entries, cachePath, nil
+}
+
+func readVersionCache(ctx context.Context, url string, ttl time.Duration) (platformVersions, bool) {
+	entries, cachePath, err := loadVersionCache()
+	if err != nil {
+		tflog.Debug(ctx, "Unable to read platform version cache", map[string]interface{}{
+			"path":  cachePath,
+			"error": err.Error(),
+		})
+		return platformVersions{}, false
+	}
+
+	versions, ok := entries[versionCacheKey(url)]
+	if !ok || time.Since(versions.DetectedAt) > ttl {
+		return platformVersions{}, false
+	}
+	return versions, true
+}
+
+// writeVersionCache stores the versions for the URL. Failures are logged and otherwise ignored
+// since the cache is only an optimisation.
+func writeVersionCache(ctx context.Context, url string, versions platformVersions) {
+	entries, cachePath, err := loadVersionCache()
+	if err == nil {
+		entries[versionCacheKey(url)] = versions
+		err = writeFileAtomic(cachePath, entries)
+	}
+	if err != nil {
+		tflog.Debug(ctx, "Unable to write platform version cache", map[string]interface{}{
+			"path":  cachePath,
+			"error": err.Error(),
+		})
+	}
+}
+
+func writeFileAtomic(cachePath string, entries map[string]platformVersions) error {
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cachePath), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(cachePath), versionCacheFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), cachePath)
+}
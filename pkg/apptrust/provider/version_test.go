@@ -0,0 +1,128 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// versionServer serves the Artifactory and Xray version endpoints and counts the probes.
+func versionServer(t *testing.T, artifactoryVersion string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var probes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/artifactory/api/system/version":
+			_, _ = w.Write([]byte(`{"version":"` + artifactoryVersion + `"}`))
+		case "/xray/api/v1/system/version":
+			_, _ = w.Write([]byte(`{"xray_version":"` + MinXrayVersion + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &probes
+}
+
+// isolateVersionCache points the user cache directory at a temporary directory.
+func isolateVersionCache(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+}
+
+func TestVersionCacheKey(t *testing.T) {
+	if versionCacheKey("https://Example.JFrog.io/") != versionCacheKey("https://example.jfrog.io") {
+		t.Error("expected the cache key to ignore case and a trailing slash")
+	}
+	if versionCacheKey("https://a.jfrog.io") == versionCacheKey("https://b.jfrog.io") {
+		t.Error("expected different URLs to have different cache keys")
+	}
+}
+
+func TestDetectPlatformVersions_cache(t *testing.T) {
+	isolateVersionCache(t)
+	ctx := context.Background()
+	server, probes := versionServer(t, MinArtifactoryVersion)
+	restyClient := resty.New().SetBaseURL(server.URL)
+
+	versions, diags := detectPlatformVersions(ctx, restyClient, server.URL, time.Hour)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if versions.Artifactory != MinArtifactoryVersion || versions.Xray != MinXrayVersion || probes.Load() != 2 {
+		t.Fatalf("expected both versions to be probed, got %+v after %d probes", versions, probes.Load())
+	}
+
+	// A fresh entry for the same URL, with a different spelling, is read from the cache.
+	versions, diags = detectPlatformVersions(ctx, restyClient, server.URL+"/", time.Hour)
+	if diags.HasError() || versions.Artifactory != MinArtifactoryVersion || probes.Load() != 2 {
+		t.Errorf("expected the cached versions, got %+v after %d probes (%v)", versions, probes.Load(), diags)
+	}
+
+	// Another URL misses the cache.
+	if _, ok := readVersionCache(ctx, "https://other.jfrog.io", time.Hour); ok {
+		t.Error("expected no cache entry for another URL")
+	}
+
+	// An entry older than the TTL is probed again.
+	time.Sleep(10 * time.Millisecond)
+	if _, diags = detectPlatformVersions(ctx, restyClient, server.URL, time.Millisecond); diags.HasError() || probes.Load() != 4 {
+		t.Errorf("expected the expired entry to be probed again, got %d probes (%v)", probes.Load(), diags)
+	}
+}
+
+func TestDetectPlatformVersions_noCache(t *testing.T) {
+	isolateVersionCache(t)
+	ctx := context.Background()
+	server, probes := versionServer(t, MinArtifactoryVersion)
+	restyClient := resty.New().SetBaseURL(server.URL)
+
+	for range 2 {
+		if _, diags := detectPlatformVersions(ctx, restyClient, server.URL, 0); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+	if probes.Load() != 4 {
+		t.Errorf("expected every call to probe without a TTL, got %d probes", probes.Load())
+	}
+	if _, ok := readVersionCache(ctx, server.URL, time.Hour); ok {
+		t.Error("expected nothing to be cached without a TTL")
+	}
+}
+
+func TestDetectPlatformVersions_incompatible(t *testing.T) {
+	isolateVersionCache(t)
+	ctx := context.Background()
+	server, _ := versionServer(t, "7.0.0")
+
+	_, diags := detectPlatformVersions(ctx, resty.New().SetBaseURL(server.URL), server.URL, time.Hour)
+	if !diags.HasError() || diags[0].Summary() != "Incompatible Artifactory version" {
+		t.Fatalf("expected an incompatible version error, got %v", diags)
+	}
+	if _, ok := readVersionCache(ctx, server.URL, time.Hour); ok {
+		t.Error("expected an incompatible version not to be cached")
+	}
+}
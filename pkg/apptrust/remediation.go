@@ -0,0 +1,146 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+// remediation is an actionable hint for API errors. Empty or zero fields match any error.
+type remediation struct {
+	code         string
+	statusCode   int
+	operation    string
+	resourceType string
+	hint         string
+}
+
+const invalidTokenHint = "Check that access_token (or JFROG_ACCESS_TOKEN) is valid and not expired. With OIDC authentication, check oidc_provider_name and the identity mappings of the platform."
+
+// remediations is the catalog of hints appended to API error diagnostics. The first matching
+// entry wins, so specific entries come before generic ones.
+//
+// Entries match on the status code rather than the error code: the `code` of the AppTrust API
+// errors repeats the status (BAD_REQUEST, UNAUTHORIZED, FORBIDDEN, NOT_FOUND, CONFLICT) and does
+// not tell apart, e.g., a missing permission from a stage outside the project lifecycle, which
+// the operation and resource type do. The exception is UNAUTHORIZED, which the platform also
+// returns for an invalid or expired token with another status, so it is matched by code.
+var remediations = []remediation{
+	{
+		code: "UNAUTHORIZED",
+		hint: invalidTokenHint,
+	},
+	{
+		statusCode: http.StatusForbidden,
+		operation:  "promote",
+		hint:       "The user of the access token needs permission to promote application versions to the target stage in the project of the application. Also check that the stage is part of the project lifecycle.",
+	},
+	{
+		statusCode: http.StatusForbidden,
+		operation:  "release",
+		hint:       "Releasing promotes the version to the PROD stage. The user of the access token needs permission to promote application versions to PROD in the project of the application.",
+	},
+	{
+		statusCode: http.StatusForbidden,
+		operation:  "rollback",
+		hint:       "The user of the access token needs permission to promote application versions in the project of the application to roll them back.",
+	},
+	{
+		statusCode:   http.StatusForbidden,
+		resourceType: "bound package",
+		hint:         "Binding a package needs permission to manage the application and read permission on the repository of the package.",
+	},
+	{
+		statusCode:   http.StatusForbidden,
+		resourceType: "application",
+		hint:         "The user of the access token needs permission to manage applications in the project (e.g. the Project Admin role).",
+	},
+	{
+		statusCode: http.StatusForbidden,
+		hint:       "Check the project roles of the user of the access token.",
+	},
+	{
+		statusCode:   http.StatusConflict,
+		operation:    "create",
+		resourceType: "bound package",
+		hint:         "The package version is already bound to an application. Unbind it from that application, or import the existing binding with terraform import.",
+	},
+	{
+		statusCode:   http.StatusConflict,
+		operation:    "create",
+		resourceType: "application version",
+		hint:         "The application already has this version. Import it with terraform import, or use another version.",
+	},
+	{
+		statusCode:   http.StatusConflict,
+		operation:    "create",
+		resourceType: "application",
+		hint:         "An application with this key already exists. Import it with terraform import, or use another application_key.",
+	},
+	{
+		statusCode: http.StatusNotFound,
+		operation:  "promote",
+		hint:       "Check that the application version exists and that the target stage is part of the project lifecycle.",
+	},
+	{
+		statusCode: http.StatusNotFound,
+		operation:  "rollback",
+		hint:       "Check that the application version exists and was promoted to the stage it is rolled back from.",
+	},
+	{
+		statusCode: http.StatusUnauthorized,
+		hint:       invalidTokenHint,
+	},
+	{
+		statusCode: http.StatusTooManyRequests,
+		hint:       "The platform rate limited the provider. Lower requests_per_second or max_concurrent_requests in the provider configuration.",
+	},
+}
+
+// matches reports whether the remediation applies to an error of an operation on a resource
+// type, with the given status code and error codes.
+func (r remediation) matches(statusCode int, operation, resourceType string, errs []client.Error) bool {
+	if r.statusCode != 0 && r.statusCode != statusCode {
+		return false
+	}
+	if r.operation != "" && r.operation != operation {
+		return false
+	}
+	if r.resourceType != "" && r.resourceType != resourceType {
+		return false
+	}
+	if r.code == "" {
+		return true
+	}
+	for _, e := range errs {
+		if strings.EqualFold(e.Code, r.code) {
+			return true
+		}
+	}
+	return false
+}
+
+// remediationHint returns the hint of the first remediation matching the error, or "".
+func remediationHint(statusCode int, operation, resourceType string, errs []client.Error) string {
+	for _, r := range remediations {
+		if r.matches(statusCode, operation, resourceType, errs) {
+			return r.hint
+		}
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+func TestHandleAPIErrorWithType_remediation(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusCode   int
+		body         string
+		operation    string
+		resourceType string
+		wantHint     string
+	}{
+		{"forbidden promote", http.StatusForbidden, `{"errors":[{"code":"FORBIDDEN","message":"Forbidden"}]}`, "promote", "application version", "permission to promote application versions to the target stage"},
+		{"forbidden application", http.StatusForbidden, `{"errors":[{"code":"FORBIDDEN","message":"Forbidden"}]}`, "update", "application", "permission to manage applications"},
+		{"forbidden other", http.StatusForbidden, ``, "read", "application version", "Check the project roles"},
+		{"conflict bind", http.StatusConflict, `{"errors":[{"code":"CONFLICT","message":"Already bound"}]}`, "create", "bound package", "already bound to an application"},
+		{"unauthorized code", http.StatusForbidden, `{"errors":[{"code":"UNAUTHORIZED","message":"Bad credentials"}]}`, "read", "application", "access_token"},
+		{"unauthorized status", http.StatusUnauthorized, ``, "read", "application", "access_token"},
+		{"conflict version", http.StatusConflict, `{"errors":[{"code":"CONFLICT","message":"Version '1.0.0' already exists"}]}`, "create", "application version", "already has this version"},
+		{"not found promote", http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","message":"Stage not found"}]}`, "promote", "application version", "part of the project lifecycle"},
+		{"not found rollback", http.StatusNotFound, `{"errors":[{"code":"NOT_FOUND","message":"Not found"}]}`, "rollback", "application version", "was promoted to the stage"},
+		{"rate limited", http.StatusTooManyRequests, ``, "read", "application", "requests_per_second"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := apptrust.HandleAPIErrorWithType(testResponse(tt.statusCode, tt.body), tt.operation, tt.resourceType)
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %v", diags)
+			}
+			if !strings.Contains(diags[0].Detail(), tt.wantHint) {
+				t.Errorf("expected %q in the detail %q", tt.wantHint, diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestHandleAPIErrorWithType_noRemediation(t *testing.T) {
+	response := testResponse(http.StatusBadRequest, `{"errors":[{"code":"BAD_REQUEST","message":"must not be blank"}]}`)
+	diags := apptrust.HandleAPIErrorWithType(response, "create", "application")
+	if want := "Failed to create application: BAD_REQUEST - must not be blank"; diags[0].Detail() != want {
+		t.Errorf("expected the detail %q, got %q", want, diags[0].Detail())
+	}
+}
+
+func TestHandleAPIErrorWithType_requestID(t *testing.T) {
+	for _, header := range []string{"X-Request-Id", "X-JFrog-Request-Id"} {
+		t.Run(header, func(t *testing.T) {
+			response := (&resty.Response{
+				RawResponse: &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Header:     http.Header{http.CanonicalHeaderKey(header): []string{"0123456789abcdef"}},
+				},
+			}).SetBody([]byte(`{"errors":[{"code":"BAD_REQUEST","message":"must not be blank","field":"application_name"}]}`))
+
+			for _, detail := range []string{
+				apptrust.HandleAPIErrorWithType(response, "create", "application")[0].Detail(),
+				apptrust.HandleAPIErrorWithPaths(response, "create", "application", testFieldPaths)[0].Detail(),
+			} {
+				if !strings.HasSuffix(detail, "\n\nRequest ID: 0123456789abcdef") {
+					t.Errorf("expected the request ID at the end of %q", detail)
+				}
+			}
+		})
+	}
+}
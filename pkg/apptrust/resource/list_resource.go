@@ -0,0 +1,49 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+// listPagination returns the pagination of a list resource request. Pages are no larger than
+// the limit requested by Terraform, so a small limit reads a single small page. A limit of 0 or
+// less is no limit.
+func listPagination(limit int64) client.Pagination {
+	if limit <= 0 || limit >= client.DefaultPageSize {
+		return client.Pagination{}
+	}
+	return client.Pagination{Limit: &limit}
+}
+
+// limitResults stops results after limit results, the maximum number of results requested by
+// Terraform. A limit of 0 or less is no limit.
+func limitResults(results iter.Seq[list.ListResult], limit int64) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var pushed int64
+		for result := range results {
+			if !push(result) {
+				return
+			}
+			pushed++
+			if limit > 0 && pushed >= limit {
+				return
+			}
+		}
+	}
+}
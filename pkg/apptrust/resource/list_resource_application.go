@@ -0,0 +1,194 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+var _ list.ListResource = &ApplicationListResource{}
+var _ list.ListResourceWithConfigure = &ApplicationListResource{}
+
+func NewApplicationListResource() list.ListResource {
+	return &ApplicationListResource{
+		TypeName: "apptrust_application",
+	}
+}
+
+// ApplicationListResource lists applications for `terraform query`, with the filters of the
+// apptrust_applications data source.
+type ApplicationListResource struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type ApplicationListResourceModel struct {
+	ProjectKey    types.String `tfsdk:"project_key"`
+	Name          types.String `tfsdk:"name"`
+	Owners        types.List   `tfsdk:"owners"`
+	MaturityLevel types.String `tfsdk:"maturity"`
+	Criticality   types.String `tfsdk:"criticality"`
+	Labels        types.List   `tfsdk:"labels"`
+}
+
+func (r *ApplicationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ApplicationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists AppTrust applications (GET /v1/applications), e.g. to generate configuration and import blocks for existing applications with `terraform query`. With `include_resource`, every application is read with one more request, as the list endpoint does not return every attribute.",
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Description: "Filters results by project key. Defaults to the provider `project_key`. " +
+					"If neither is specified, applications from all projects are listed.",
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Filters results by the application name.",
+				Optional:    true,
+			},
+			// The list resource schema package has no set attribute, so the owners, a set in the
+			// resource and the data sources, are a list of unique values.
+			"owners": schema.ListAttribute{
+				Description: "Filters results by application owners (user or group).",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"maturity": schema.StringAttribute{
+				Description: fmt.Sprintf("Filters results by application maturity. Allowed values: %s", strings.Join(maturityLevels, ", ")),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(maturityLevels...),
+				},
+			},
+			"criticality": schema.StringAttribute{
+				Description: fmt.Sprintf("Filters results by application criticality. Allowed values: %s", strings.Join(criticalityLevels, ", ")),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(criticalityLevels...),
+				},
+			},
+			"labels": schema.ListAttribute{
+				Description: "Filters by application labels in the format 'key:value'.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[^:]+:[^:]+$`),
+							"label must be in format 'key:value'",
+						),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
+}
+
+func (r *ApplicationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	r.ProviderData.Usage.RecordResource(r.TypeName, "LIST")
+
+	var config ApplicationListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	opts := client.ApplicationListOptions{
+		ProjectKey:  config.ProjectKey.ValueString(),
+		Name:        config.Name.ValueString(),
+		Maturity:    config.MaturityLevel.ValueString(),
+		Criticality: config.Criticality.ValueString(),
+	}
+	if config.ProjectKey.IsNull() {
+		opts.ProjectKey = r.ProviderData.ProjectKey
+	}
+	if !config.Owners.IsNull() {
+		diags.Append(config.Owners.ElementsAs(ctx, &opts.Owners, false)...)
+	}
+	if !config.Labels.IsNull() {
+		diags.Append(config.Labels.ElementsAs(ctx, &opts.Labels, false)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// The applications are read page by page as results are consumed, so reaching the limit stops
+	// the requests too. With include_resource each application costs one more request, as the
+	// list endpoint does not return every attribute; like every request, these are throttled by
+	// the provider max_concurrent_requests and requests_per_second settings.
+	opts.Pagination = listPagination(req.Limit)
+	stream.Results = limitResults(func(push func(list.ListResult) bool) {
+		err := r.client.Applications().ListPages(ctx, opts, func(applications []client.Application) bool {
+			for _, application := range applications {
+				result := req.NewListResult(ctx)
+				result.DisplayName = application.ApplicationName
+
+				model := ApplicationResourceModel{ApplicationKey: types.StringValue(application.ApplicationKey)}
+				result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+				if req.IncludeResource {
+					// The resource is read like on refresh.
+					found, err := r.client.Applications().Get(ctx, application.ApplicationKey)
+					if err != nil {
+						result.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application")...)
+					} else {
+						result.Diagnostics.Append(model.fromAPIModel(ctx, *found, r.ProviderData.DefaultLabels)...)
+						result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+					}
+				}
+
+				if !push(result) {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil && !client.IsNotFound(err) {
+			push(list.ListResult{Diagnostics: apptrust.HandleClientError(err, "list", "application")})
+		}
+	}, req.Limit)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest/fakeserver"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// countingServer forwards to a fake AppTrust server and counts the list and get application requests.
+func countingServer(t *testing.T, applications int) (*resty.Client, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+	fake := fakeserver.New("aa")
+	t.Cleanup(fake.Close)
+	target, err := url.Parse(fake.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var lists, gets atomic.Int32
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/apptrust/api/v1/applications" {
+			lists.Add(1)
+		} else if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/apptrust/api/v1/applications/") {
+			gets.Add(1)
+		}
+		proxy.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	restyClient := resty.New().SetBaseURL(server.URL).SetAuthToken(fakeserver.AccessToken)
+	for i := range applications {
+		_, err := client.New(restyClient).Applications().Create(context.Background(), client.Application{
+			ApplicationKey:  fmt.Sprintf("app-%02d", i),
+			ApplicationName: fmt.Sprintf("app-%02d", i),
+			ProjectKey:      "aa",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return restyClient, &lists, &gets
+}
+
+func listApplications(t *testing.T, restyClient *resty.Client, limit int64, includeResource bool) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	r := NewApplicationListResource().(*ApplicationListResource)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: apptrust.ProviderMetadata{
+		ProviderMetadata: util.ProviderMetadata{Client: restyClient},
+	}}, &resource.ConfigureResponse{})
+
+	var configSchema list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	(&ApplicationResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	(&ApplicationResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	configType := configSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, attrType := range configType.AttributeTypes {
+		config[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchema.Schema, Raw: tftypes.NewValue(configType, config)},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestApplicationListResource_limit(t *testing.T) {
+	restyClient, lists, gets := countingServer(t, 30)
+
+	results := listApplications(t, restyClient, 3, true)
+	if len(results) != 3 || lists.Load() != 1 || gets.Load() != 3 {
+		t.Errorf("expected 3 results from one page and 3 reads, got %d results, %d pages and %d reads", len(results), lists.Load(), gets.Load())
+	}
+
+	lists.Store(0)
+	gets.Store(0)
+	results = listApplications(t, restyClient, 0, false)
+	if len(results) != 30 || gets.Load() != 0 {
+		t.Errorf("expected all 30 applications without reads, got %d results and %d reads", len(results), gets.Load())
+	}
+}
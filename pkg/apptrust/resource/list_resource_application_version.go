@@ -0,0 +1,150 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+var _ list.ListResource = &ApplicationVersionListResource{}
+var _ list.ListResourceWithConfigure = &ApplicationVersionListResource{}
+
+func NewApplicationVersionListResource() list.ListResource {
+	return &ApplicationVersionListResource{
+		TypeName: "apptrust_application_version",
+	}
+}
+
+// ApplicationVersionListResource lists the versions of an application for `terraform query`,
+// with the filters of the apptrust_application_versions data source.
+type ApplicationVersionListResource struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type ApplicationVersionListResourceModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	ReleaseStatus  types.String `tfsdk:"release_status"`
+	Tag            types.String `tfsdk:"tag"`
+}
+
+func (r *ApplicationVersionListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ApplicationVersionListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the versions of an AppTrust application (GET /v1/applications/{application_key}/versions), e.g. to generate " +
+			"configuration and import blocks for existing versions with `terraform query`. The sources of a version are not returned by the API " +
+			"and are left out of the generated configuration.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application key.",
+				Required:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Filter by the user who created the application version.",
+				Optional:    true,
+			},
+			"release_status": schema.StringAttribute{
+				Description: "Filter by release status: released, pre_release, trusted_release. Comma-separated for multiple.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Filter by tag. Supports trailing wildcard (*) and comma-separated values.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationVersionListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
+}
+
+func (r *ApplicationVersionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	r.ProviderData.Usage.RecordResource(r.TypeName, "LIST")
+
+	var config ApplicationVersionListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	applicationKey := config.ApplicationKey.ValueString()
+	// The versions are read page by page as results are consumed, so reaching the limit stops the
+	// requests too.
+	stream.Results = limitResults(func(push func(list.ListResult) bool) {
+		err := r.client.Versions().ListPages(ctx, applicationKey, client.VersionListOptions{
+			CreatedBy:     config.CreatedBy.ValueString(),
+			ReleaseStatus: config.ReleaseStatus.ValueString(),
+			Tag:           config.Tag.ValueString(),
+			Pagination:    listPagination(req.Limit),
+		}, func(versions []client.Version) bool {
+			for _, version := range versions {
+				result := req.NewListResult(ctx)
+				result.DisplayName = apptrust.VersionID{ApplicationKey: applicationKey, Version: version.Version}.String()
+
+				model := ApplicationVersionResourceModel{
+					ID:             types.StringValue(result.DisplayName),
+					ApplicationKey: types.StringValue(applicationKey),
+					Version:        types.StringValue(version.Version),
+					Tag:            types.StringValue(version.Tag),
+					ReleaseStatus:  types.StringValue(version.ReleaseStatus),
+					CurrentStage:   types.StringValue(version.CurrentStage),
+				}
+				result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+
+				// The resource is set per attribute, leaving the sources, which the list endpoint does
+				// not return, null.
+				if req.IncludeResource {
+					for name, value := range map[string]types.String{
+						"id":              model.ID,
+						"application_key": model.ApplicationKey,
+						"version":         model.Version,
+						"tag":             model.Tag,
+						"release_status":  model.ReleaseStatus,
+						"current_stage":   model.CurrentStage,
+					} {
+						result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(name), value)...)
+					}
+				}
+
+				if !push(result) {
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			push(list.ListResult{Diagnostics: apptrust.HandleClientError(err, "list", "application version")})
+		}
+	}, req.Limit)
+}
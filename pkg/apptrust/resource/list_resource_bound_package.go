@@ -0,0 +1,143 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+var _ list.ListResource = &BoundPackageListResource{}
+var _ list.ListResourceWithConfigure = &BoundPackageListResource{}
+
+func NewBoundPackageListResource() list.ListResource {
+	return &BoundPackageListResource{
+		TypeName: "apptrust_bound_package",
+	}
+}
+
+// BoundPackageListResource lists the package versions bound to an application for
+// `terraform query`, with the filters of the apptrust_application_package_bindings data source.
+type BoundPackageListResource struct {
+	ProviderData apptrust.ProviderMetadata
+	TypeName     string
+	client       client.Client
+}
+
+type BoundPackageListResourceModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+}
+
+func (r *BoundPackageListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *BoundPackageListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the package versions bound to an AppTrust application (GET /v1/applications/{application_key}/packages), " +
+			"e.g. to generate configuration and import blocks for existing bindings with `terraform query`. Every bound version is a result.",
+		Attributes: map[string]schema.Attribute{
+			"application_key": schema.StringAttribute{
+				Description: "The application key.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Filter by package name.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Filter by package type (e.g. maven, docker, npm).",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *BoundPackageListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
+}
+
+func (r *BoundPackageListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	r.ProviderData.Usage.RecordResource(r.TypeName, "LIST")
+
+	var config BoundPackageListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	applicationKey := config.ApplicationKey.ValueString()
+	// The packages, and the versions of each package, are read as results are consumed, so
+	// reaching the limit stops the requests too.
+	stream.Results = limitResults(func(push func(list.ListResult) bool) {
+		err := r.client.Packages().ListPages(ctx, applicationKey, client.PackageListOptions{
+			Name:       config.Name.ValueString(),
+			Type:       config.Type.ValueString(),
+			Pagination: listPagination(req.Limit),
+		}, func(packages []client.Package) bool {
+			for _, pkg := range packages {
+				versions, err := r.client.Packages().ListAllVersions(ctx, applicationKey, pkg.Type, pkg.Name, client.PackageVersionListOptions{})
+				if err != nil {
+					push(list.ListResult{Diagnostics: apptrust.HandleClientError(err, "list", "bound package")})
+					return false
+				}
+
+				for _, version := range versions {
+					id := apptrust.BoundPackageID{
+						ApplicationKey: applicationKey,
+						PackageType:    pkg.Type,
+						PackageName:    pkg.Name,
+						PackageVersion: version.Version,
+					}
+					result := req.NewListResult(ctx)
+					result.DisplayName = id.String()
+
+					model := BoundPackageResourceModel{
+						ID:             types.StringValue(id.String()),
+						ApplicationKey: types.StringValue(id.ApplicationKey),
+						PackageType:    types.StringValue(id.PackageType),
+						PackageName:    types.StringValue(id.PackageName),
+						PackageVersion: types.StringValue(id.PackageVersion),
+					}
+					result.Diagnostics.Append(result.Identity.Set(ctx, model.identity())...)
+					if req.IncludeResource {
+						result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+					}
+
+					if !push(result) {
+						return false
+					}
+				}
+			}
+			return true
+		})
+		if err != nil {
+			push(list.ListResult{Diagnostics: apptrust.HandleClientError(err, "list", "bound package")})
+		}
+	}, req.Limit)
+}
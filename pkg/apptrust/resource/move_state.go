@@ -0,0 +1,93 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Release Bundle v2 resource types of the JFrog Artifactory provider. An AppTrust application
+// version is stored as a Release Bundle v2 named after the application key, so their state moves
+// to apptrust_application_version and apptrust_application_version_promotion.
+const (
+	releaseBundleV2TypeName          = "artifactory_release_bundle_v2"
+	releaseBundleV2PromotionTypeName = "artifactory_release_bundle_v2_promotion"
+)
+
+// releaseBundleV2SourceSchema is the part of the artifactory_release_bundle_v2 schema moved to
+// apptrust_application_version. Other source attributes are ignored.
+var releaseBundleV2SourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"version": schema.StringAttribute{
+			Required: true,
+		},
+	},
+}
+
+type releaseBundleV2SourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+// releaseBundleV2PromotionSourceSchema is the part of the artifactory_release_bundle_v2_promotion
+// schema moved to apptrust_application_version_promotion. Other source attributes are ignored.
+var releaseBundleV2PromotionSourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"version": schema.StringAttribute{
+			Required: true,
+		},
+		"environment": schema.StringAttribute{
+			Required: true,
+		},
+	},
+}
+
+type releaseBundleV2PromotionSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Version     types.String `tfsdk:"version"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+// isArtifactoryMove reports whether req moves a resource of type typeName of the JFrog Artifactory
+// provider. The provider hostname is ignored so the registry mirror does not matter.
+func isArtifactoryMove(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.HasSuffix(req.SourceProviderAddress, "/jfrog/artifactory")
+}
+
+// getMoveSourceState reads the source state of req into target. The framework leaves SourceState
+// nil when the source state does not match the SourceSchema of the StateMover.
+func getMoveSourceState(ctx context.Context, req resource.MoveStateRequest, target any, diags *diag.Diagnostics) {
+	if req.SourceState == nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The state of %s (schema version %d) could not be read. Remove it from the state and import the AppTrust resource instead.", req.SourceTypeName, req.SourceSchemaVersion),
+		)
+		return
+	}
+	diags.Append(req.SourceState.Get(ctx, target)...)
+}
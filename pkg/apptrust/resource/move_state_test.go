@@ -0,0 +1,142 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const artifactoryProviderAddress = "registry.terraform.io/jfrog/artifactory"
+
+// moveState runs the state movers of r like the framework does and returns the first response
+// with state or diagnostics.
+func moveState(t *testing.T, r resource.ResourceWithMoveState, typeName, providerAddress string, source map[string]tftypes.Value) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	for _, mover := range r.MoveState(ctx) {
+		sourceType := mover.SourceSchema.Type().TerraformType(ctx)
+		req := resource.MoveStateRequest{
+			SourceTypeName:        typeName,
+			SourceProviderAddress: providerAddress,
+			SourceState: &tfsdk.State{
+				Schema: *mover.SourceSchema,
+				Raw:    tftypes.NewValue(sourceType, source),
+			},
+		}
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+			TargetIdentity: &tfsdk.ResourceIdentity{
+				Schema: identityResp.IdentitySchema,
+				Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			return resp
+		}
+	}
+	return nil
+}
+
+func TestApplicationVersionMoveState(t *testing.T) {
+	resp := moveState(t, &ApplicationVersionResource{}, releaseBundleV2TypeName, artifactoryProviderAddress, map[string]tftypes.Value{
+		"name":    tftypes.NewValue(tftypes.String, "my-app"),
+		"version": tftypes.NewValue(tftypes.String, "1.0.0"),
+	})
+	if resp == nil {
+		t.Fatal("expected the release bundle state to be moved")
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state ApplicationVersionResourceModel
+	if diags := resp.TargetState.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ApplicationKey.ValueString() != "my-app" || state.Version.ValueString() != "1.0.0" || state.ID.ValueString() != "my-app:1.0.0" {
+		t.Errorf("unexpected state %+v", state)
+	}
+	var identity ApplicationVersionIdentityModel
+	if diags := resp.TargetIdentity.Get(context.Background(), &identity); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if identity.ApplicationKey.ValueString() != "my-app" || identity.Version.ValueString() != "1.0.0" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+}
+
+func TestApplicationVersionPromotionMoveState(t *testing.T) {
+	resp := moveState(t, &ApplicationVersionPromotionResource{}, releaseBundleV2PromotionTypeName, artifactoryProviderAddress, map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "my-app"),
+		"version":     tftypes.NewValue(tftypes.String, "1.0.0"),
+		"environment": tftypes.NewValue(tftypes.String, "QA"),
+	})
+	if resp == nil {
+		t.Fatal("expected the release bundle promotion state to be moved")
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state ApplicationVersionPromotionResourceModel
+	if diags := resp.TargetState.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ApplicationKey.ValueString() != "my-app" || state.Version.ValueString() != "1.0.0" || state.TargetStage.ValueString() != "QA" || state.ID.ValueString() != "my-app:1.0.0:QA" {
+		t.Errorf("unexpected state %+v", state)
+	}
+	var identity ApplicationVersionPromotionIdentityModel
+	if diags := resp.TargetIdentity.Get(context.Background(), &identity); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if identity.TargetStage.ValueString() != "QA" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+}
+
+func TestMoveState_otherSources(t *testing.T) {
+	source := map[string]tftypes.Value{
+		"name":    tftypes.NewValue(tftypes.String, "my-app"),
+		"version": tftypes.NewValue(tftypes.String, "1.0.0"),
+	}
+	for name, tc := range map[string]struct {
+		typeName        string
+		providerAddress string
+	}{
+		"other type":     {"artifactory_release_bundle_v2_promotion", artifactoryProviderAddress},
+		"other provider": {releaseBundleV2TypeName, "registry.terraform.io/example/artifactory"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if resp := moveState(t, &ApplicationVersionResource{}, tc.typeName, tc.providerAddress, source); resp != nil {
+				t.Errorf("expected the move to be skipped, got %+v", resp)
+			}
+		})
+	}
+}
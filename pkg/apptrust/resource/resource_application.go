@@ -17,17 +17,19 @@ package resource
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -35,17 +37,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
-)
-
-// Application API endpoints (used by this resource and application datasources)
-const (
-	ApplicationsEndpoint = "apptrust/api/v1/applications"
-	ApplicationEndpoint  = ApplicationsEndpoint + "/{application_key}"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &ApplicationResource{}
+var _ resource.ResourceWithIdentity = &ApplicationResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationResource{}
+var _ resource.ResourceWithUpgradeState = &ApplicationResource{}
 
 func NewApplicationResource() resource.Resource {
 	return &ApplicationResource{
@@ -54,8 +52,9 @@ func NewApplicationResource() resource.Resource {
 }
 
 type ApplicationResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type ApplicationResourceModel struct {
@@ -67,43 +66,63 @@ type ApplicationResourceModel struct {
 	MaturityLevel   types.String `tfsdk:"maturity_level"`
 	Criticality     types.String `tfsdk:"criticality"`
 	Labels          types.Map    `tfsdk:"labels"`
-	UserOwners      types.List   `tfsdk:"user_owners"`
-	GroupOwners     types.List   `tfsdk:"group_owners"`
+	LabelsAll       types.Map    `tfsdk:"labels_all"`
+	UserOwners      types.Set    `tfsdk:"user_owners"`
+	GroupOwners     types.Set    `tfsdk:"group_owners"`
+	ForceDestroy    types.Bool   `tfsdk:"force_destroy"`
+	DestroyBehavior types.String `tfsdk:"destroy_behavior"`
+	Created         types.String `tfsdk:"created"`
+	CreatedBy       types.String `tfsdk:"created_by"`
+	Modified        types.String `tfsdk:"modified"`
+	ModifiedBy      types.String `tfsdk:"modified_by"`
+	VersionsCount   types.Int64  `tfsdk:"versions_count"`
+	LatestVersion   types.String `tfsdk:"latest_version"`
 }
 
-type ApplicationAPIModel struct {
-	ApplicationKey  string            `json:"application_key"`
-	ApplicationName string            `json:"application_name"`
-	ProjectKey      string            `json:"project_key"`
-	Description     string            `json:"description,omitempty"`
-	MaturityLevel   string            `json:"maturity_level,omitempty"`
-	Criticality     string            `json:"criticality,omitempty"`
-	Labels          map[string]string `json:"labels,omitempty"`
-	UserOwners      []string          `json:"user_owners,omitempty"`
-	GroupOwners     []string          `json:"group_owners,omitempty"`
+// ApplicationIdentityModel identifies an application by its key.
+type ApplicationIdentityModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
 }
 
-type UpdateApplicationAPIModel struct {
-	ApplicationName *string           `json:"application_name,omitempty"`
-	Description     *string           `json:"description,omitempty"`
-	MaturityLevel   *string           `json:"maturity_level,omitempty"`
-	Criticality     *string           `json:"criticality,omitempty"`
-	Labels          map[string]string `json:"labels"`       // No omitempty - empty map must be sent to clear
-	UserOwners      []string          `json:"user_owners"`  // No omitempty - empty array must be sent to clear
-	GroupOwners     []string          `json:"group_owners"` // No omitempty - empty array must be sent to clear
+// applicationFieldPaths maps the fields of application request errors to the attributes they are sent from.
+var applicationFieldPaths = apptrust.FieldPaths{
+	"application_key":  {Path: path.Root("application_key")},
+	"application_name": {Path: path.Root("application_name")},
+	"project_key":      {Path: path.Root("project_key")},
+	"description":      {Path: path.Root("description")},
+	"maturity_level":   {Path: path.Root("maturity_level")},
+	"criticality":      {Path: path.Root("criticality")},
+	"labels":           {Path: path.Root("labels"), MapKeys: true},
+	"user_owners":      {Path: path.Root("user_owners"), Set: true},
+	"group_owners":     {Path: path.Root("group_owners"), Set: true},
 }
 
+// forceDestroyConcurrency bounds the versions deleted and packages unbound at a time by a force
+// destroy.
+const forceDestroyConcurrency = 4
+
 var (
 	maturityLevels    = []string{"unspecified", "experimental", "production", "end_of_life"}
 	criticalityLevels = []string{"unspecified", "low", "medium", "high", "critical"}
 )
 
+// destroy_behavior values.
+const (
+	destroyBehaviorDelete  = "delete"
+	destroyBehaviorProtect = "protect"
+	destroyBehaviorArchive = "archive"
+)
+
+var destroyBehaviors = []string{destroyBehaviorDelete, destroyBehaviorProtect, destroyBehaviorArchive}
+
 func (r *ApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = r.TypeName
 }
 
 func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 changed user_owners and group_owners from lists to sets.
+		Version: 1,
 		MarkdownDescription: "Provides an AppTrust application resource. This resource allows you to create, update, and delete AppTrust applications. " +
 			"Applications are business-aware entities that serve as a definitive, centralized system of record for all software assets throughout their lifecycle.",
 		Attributes: map[string]schema.Attribute{
@@ -138,10 +157,16 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"project_key": schema.StringAttribute{
-				Description: "The key of the project associated with the application. Cannot be changed after creation. Changing this field will force replacement of the resource.",
-				Required:    true,
+				Description: "The key of the project associated with the application. Defaults to the provider `project_key`. " +
+					"Cannot be changed after creation. Changing this field, or the provider default when it is not set, will force replacement of the resource.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					// Replacement is decided in ModifyPlan, on the effective project key.
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -178,26 +203,96 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
-			"user_owners": schema.ListAttribute{
-				Description: "List of users defined in the project who own the application. Each user must be at least 1 character in length.",
+			"labels_all": schema.MapAttribute{
+				Description: "All labels of the application: `labels` merged over the provider `default_labels`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"user_owners": schema.SetAttribute{
+				Description: "Set of users defined in the project who own the application. Each user must be at least 1 character in length.",
 				ElementType: types.StringType,
 				Optional:    true,
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
 						stringvalidator.LengthAtLeast(1),
 					),
 				},
 			},
-			"group_owners": schema.ListAttribute{
-				Description: "List of user groups defined in the project who own the application. Each group must be at least 1 character in length.",
+			"group_owners": schema.SetAttribute{
+				Description: "Set of user groups defined in the project who own the application. Each group must be at least 1 character in length.",
 				ElementType: types.StringType,
 				Optional:    true,
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
 						stringvalidator.LengthAtLeast(1),
 					),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "When true, destroying the application first deletes all of its versions and unbinds all of its bound packages, " +
+					"which the API requires before an application can be deleted. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"destroy_behavior": schema.StringAttribute{
+				Description: fmt.Sprintf("What destroying the application does. Allowed values: %s. `delete` deletes the application. ", strings.Join(destroyBehaviors, ", ")) +
+					"`protect` makes destroy fail until destroy_behavior is changed and applied. " +
+					"`archive` keeps the application, sets its maturity_level to end_of_life and removes the provider `default_labels` from it. " +
+					"force_destroy only applies to `delete`. Defaults to 'delete'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(destroyBehaviorDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(destroyBehaviors...),
+				},
+			},
+			"created": schema.StringAttribute{
+				Description: "The time the application was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "The user who created the application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// The attributes below deliberately have no UseStateForUnknown. modified and modified_by
+			// change on every update. versions_count and latest_version change whenever a version
+			// is created or deleted, which happens outside this resource and can race an update,
+			// so a plan carrying the prior value could be inconsistent with the result. They are
+			// shown as known after apply instead.
+			"modified": schema.StringAttribute{
+				Description: "The time the application was last modified.",
+				Computed:    true,
+			},
+			"modified_by": schema.StringAttribute{
+				Description: "The user who last modified the application.",
+				Computed:    true,
+			},
+			"versions_count": schema.Int64Attribute{
+				Description: "The number of versions of the application.",
+				Computed:    true,
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "The latest version of the application.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -206,11 +301,12 @@ func (r *ApplicationResource) Configure(ctx context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan ApplicationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -218,56 +314,16 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	apiModel, diags := plan.toAPIModel(ctx)
+	apiModel, diags := plan.toAPIModel(ctx, r.ProviderData.DefaultLabels)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// All fields are optional except application_key, application_name, and project_key
-	createBody := map[string]interface{}{
-		"application_key":  apiModel.ApplicationKey,
-		"application_name": apiModel.ApplicationName,
-		"project_key":      apiModel.ProjectKey,
-	}
-	if apiModel.Description != "" {
-		createBody["description"] = apiModel.Description
-	}
-	if apiModel.MaturityLevel != "" {
-		createBody["maturity_level"] = apiModel.MaturityLevel
-	}
-	if apiModel.Criticality != "" {
-		createBody["criticality"] = apiModel.Criticality
-	}
-	if len(apiModel.Labels) > 0 {
-		createBody["labels"] = apiModel.Labels
-	}
-	// Only send user_owners/group_owners when there are items. Null or empty list [] = don't send (API treats as no owners).
-	if len(apiModel.UserOwners) > 0 {
-		createBody["user_owners"] = apiModel.UserOwners
-	}
-	if len(apiModel.GroupOwners) > 0 {
-		createBody["group_owners"] = apiModel.GroupOwners
-	}
-
-	var result ApplicationAPIModel
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetBody(createBody).
-		SetResult(&result).
-		Post(ApplicationsEndpoint)
-
+	// Optional fields are omitted when empty. Null or empty owners [] are not sent (API treats as no owners).
+	result, err := r.client.Applications().Create(ctx, apiModel)
 	if err != nil {
-		tflog.Error(ctx, "Failed to send create request", map[string]interface{}{
-			"application_key": plan.ApplicationKey.ValueString(),
-			"error":           err.Error(),
-		})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusCreated {
-		if httpResponse.StatusCode() == http.StatusConflict {
+		if client.IsConflict(err) {
 			tflog.Warn(ctx, "Application already exists", map[string]interface{}{
 				"application_key": plan.ApplicationKey.ValueString(),
 			})
@@ -277,8 +333,11 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 			)
 			return
 		}
-		errorDiags := apptrust.HandleAPIError(httpResponse, "create")
-		resp.Diagnostics.Append(errorDiags...)
+		tflog.Error(ctx, "Failed to send create request", map[string]interface{}{
+			"application_key": plan.ApplicationKey.ValueString(),
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "create", "application", applicationFieldPaths)...)
 		return
 	}
 
@@ -302,7 +361,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 	}
 
-	diags = plan.fromAPIModel(ctx, result)
+	diags = plan.fromAPIModel(ctx, *result, r.ProviderData.DefaultLabels)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -313,24 +372,25 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	if planHadEmptyDescription && result.Description == "" {
 		plan.Description = types.StringValue("")
 	}
-	if planHadEmptyLabels && len(result.Labels) == 0 {
+	if planHadEmptyLabels && plan.Labels.IsNull() {
 		plan.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
 	}
 	if planHadEmptyUserOwners && (result.UserOwners == nil || len(result.UserOwners) == 0) {
-		plan.UserOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		plan.UserOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 	if planHadEmptyGroupOwners && (result.GroupOwners == nil || len(result.GroupOwners) == 0) {
-		plan.GroupOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		plan.GroupOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 
 	// Ensure ID is always set to application_key (computed field)
 	plan.ID = types.StringValue(plan.ApplicationKey.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state ApplicationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -347,32 +407,20 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		"application_key": applicationKey,
 	})
 
-	var result ApplicationAPIModel
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetResult(&result).
-		Get(ApplicationEndpoint)
-
+	result, err := r.client.Applications().Get(ctx, applicationKey)
 	if err != nil {
-		tflog.Error(ctx, "Failed to send read request", map[string]interface{}{
-			"application_key": applicationKey,
-			"error":           err.Error(),
-		})
-		utilfw.UnableToRefreshResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			tflog.Warn(ctx, "Application not found, removing from state", map[string]interface{}{
 				"application_key": applicationKey,
 			})
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		errorDiags := apptrust.HandleAPIError(httpResponse, "read")
-		resp.Diagnostics.Append(errorDiags...)
+		tflog.Error(ctx, "Failed to send read request", map[string]interface{}{
+			"application_key": applicationKey,
+			"error":           err.Error(),
+		})
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application")...)
 		return
 	}
 
@@ -397,7 +445,7 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 	}
 
-	diags := state.fromAPIModel(ctx, result)
+	diags := state.fromAPIModel(ctx, *result, r.ProviderData.DefaultLabels)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -407,24 +455,25 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 	if stateHadEmptyDescription && result.Description == "" {
 		state.Description = types.StringValue("")
 	}
-	if stateHadEmptyLabels && len(result.Labels) == 0 {
+	if stateHadEmptyLabels && state.Labels.IsNull() {
 		state.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
 	}
 	if stateHadEmptyUserOwners && (result.UserOwners == nil || len(result.UserOwners) == 0) {
-		state.UserOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		state.UserOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 	if stateHadEmptyGroupOwners && (result.GroupOwners == nil || len(result.GroupOwners) == 0) {
-		state.GroupOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		state.GroupOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 
 	// Ensure ID is always set to application_key (computed field)
 	state.ID = types.StringValue(state.ApplicationKey.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "UPDATE")
 
 	var plan ApplicationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -438,7 +487,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	apiModel, diags := plan.toAPIModelForUpdate(ctx)
+	apiModel, diags := plan.toAPIModelForUpdate(ctx, r.ProviderData.DefaultLabels)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -450,9 +499,6 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		emptyStr := ""
 		apiModel.Description = &emptyStr
 	}
-	if plan.Labels.IsNull() && !state.Labels.IsNull() {
-		apiModel.Labels = make(map[string]string)
-	}
 	if plan.UserOwners.IsNull() && !state.UserOwners.IsNull() {
 		apiModel.UserOwners = []string{}
 	}
@@ -460,34 +506,20 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		apiModel.GroupOwners = []string{}
 	}
 
-	var result ApplicationAPIModel
 	// NOTE: The provider sends "project" query parameter for context/authorization purposes.
-	response, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetQueryParam("project", plan.ProjectKey.ValueString()).
-		SetBody(apiModel).
-		SetResult(&result).
-		Patch(ApplicationEndpoint)
-
+	apiModel.ProjectKey = plan.ProjectKey.ValueString()
+	result, err := r.client.Applications().Update(ctx, plan.ApplicationKey.ValueString(), apiModel)
 	if err != nil {
 		tflog.Error(ctx, "Failed to send update request", map[string]interface{}{
 			"application_key": plan.ApplicationKey.ValueString(),
 			"error":           err.Error(),
 		})
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
-		return
-	}
-
-	if response.StatusCode() != http.StatusOK {
-		errorDiags := apptrust.HandleAPIError(response, "update")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "update", "application", applicationFieldPaths)...)
 		return
 	}
 
 	// Track what the plan originally wanted before fromAPIModel modifies it
 	planWantedDescriptionNull := plan.Description.IsNull() && !state.Description.IsNull()
-	planWantedLabelsNull := plan.Labels.IsNull() && !state.Labels.IsNull()
 	planWantedUserOwnersNull := plan.UserOwners.IsNull() && !state.UserOwners.IsNull()
 	planWantedGroupOwnersNull := plan.GroupOwners.IsNull() && !state.GroupOwners.IsNull()
 	planHadEmptyDescription := !plan.Description.IsNull() && !plan.Description.IsUnknown() && plan.Description.ValueString() == ""
@@ -510,7 +542,7 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 	}
 
-	resp.Diagnostics.Append(plan.fromAPIModel(ctx, result)...)
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, *result, r.ProviderData.DefaultLabels)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -521,21 +553,20 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	} else if planHadEmptyDescription && result.Description == "" {
 		plan.Description = types.StringValue("")
 	}
-	if planWantedLabelsNull && len(result.Labels) == 0 {
-		plan.Labels = types.MapNull(types.StringType)
-	} else if planHadEmptyLabels && len(result.Labels) == 0 {
+	// fromAPIModel leaves labels null when the API returned no labels besides the provider default labels.
+	if planHadEmptyLabels && plan.Labels.IsNull() {
 		plan.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
 	}
-	// When plan had null and API returned nothing, set state to null. When plan had [] and API returned nothing, preserve empty list.
+	// When plan had null and API returned nothing, set state to null. When plan had [] and API returned nothing, preserve the empty set.
 	if planWantedUserOwnersNull && (result.UserOwners == nil || len(result.UserOwners) == 0) {
-		plan.UserOwners = types.ListNull(types.StringType)
+		plan.UserOwners = types.SetNull(types.StringType)
 	} else if planHadEmptyUserOwners && (result.UserOwners == nil || len(result.UserOwners) == 0) {
-		plan.UserOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		plan.UserOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 	if planWantedGroupOwnersNull && (result.GroupOwners == nil || len(result.GroupOwners) == 0) {
-		plan.GroupOwners = types.ListNull(types.StringType)
+		plan.GroupOwners = types.SetNull(types.StringType)
 	} else if planHadEmptyGroupOwners && (result.GroupOwners == nil || len(result.GroupOwners) == 0) {
-		plan.GroupOwners = types.ListValueMust(types.StringType, []attr.Value{})
+		plan.GroupOwners = types.SetValueMust(types.StringType, []attr.Value{})
 	}
 	// maturity_level and criticality are already set by fromAPIModel (with "" normalized to "unspecified").
 
@@ -543,10 +574,11 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.ID = types.StringValue(plan.ApplicationKey.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 
 	var state ApplicationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -555,40 +587,195 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	applicationKey := state.ApplicationKey.ValueString()
+	switch state.DestroyBehavior.ValueString() {
+	case destroyBehaviorProtect:
+		resp.Diagnostics.AddError(
+			"Application Is Protected",
+			fmt.Sprintf("Application '%s' has destroy_behavior = \"%s\" and is not destroyed. To destroy it, set destroy_behavior to \"%s\" or \"%s\" and apply first.",
+				applicationKey, destroyBehaviorProtect, destroyBehaviorDelete, destroyBehaviorArchive),
+		)
+		return
+	case destroyBehaviorArchive:
+		resp.Diagnostics.Append(r.archive(ctx, state)...)
+		return
+	}
+
+	if state.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.deleteContents(ctx, applicationKey)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Deleting application", map[string]interface{}{
 		"application_key": applicationKey,
 	})
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		Delete(ApplicationEndpoint)
-
-	if err != nil {
-		tflog.Error(ctx, "Failed to send delete request", map[string]interface{}{
+	err := r.client.Applications().Delete(ctx, applicationKey)
+	if err == nil {
+		return
+	}
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Application not found during delete, assuming already deleted", map[string]interface{}{
 			"application_key": applicationKey,
-			"error":           err.Error(),
 		})
-		utilfw.UnableToDeleteResourceError(resp, err.Error())
 		return
 	}
+	tflog.Error(ctx, "Failed to send delete request", map[string]interface{}{
+		"application_key": applicationKey,
+		"error":           err.Error(),
+	})
+	resp.Diagnostics.Append(apptrust.HandleClientError(err, "delete", "application")...)
+}
 
-	if httpResponse.StatusCode() == http.StatusNoContent || httpResponse.StatusCode() == http.StatusOK {
-		return
+// archive keeps an application on destroy: its maturity level becomes end_of_life and the provider
+// default_labels are removed from it, so it no longer looks managed by Terraform.
+func (r *ApplicationResource) archive(ctx context.Context, state ApplicationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	applicationKey := state.ApplicationKey.ValueString()
+
+	current, err := r.client.Applications().Get(ctx, applicationKey)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Application not found during archive, assuming already deleted", map[string]interface{}{
+				"application_key": applicationKey,
+			})
+			return diags
+		}
+		return apptrust.HandleClientError(err, "archive", "application")
 	}
-	if httpResponse.StatusCode() == http.StatusNotFound {
-		tflog.Warn(ctx, "Application not found during delete, assuming already deleted", map[string]interface{}{
+
+	labels := make(map[string]string, len(current.Labels))
+	for key, value := range current.Labels {
+		if defaultValue, ok := r.ProviderData.DefaultLabels[key]; ok && defaultValue == value {
+			continue
+		}
+		labels[key] = value
+	}
+	// Owners are always sent, as an omitted list clears them.
+	userOwners := current.UserOwners
+	if userOwners == nil {
+		userOwners = []string{}
+	}
+	groupOwners := current.GroupOwners
+	if groupOwners == nil {
+		groupOwners = []string{}
+	}
+	maturityLevel := "end_of_life"
+
+	tflog.Info(ctx, "Archiving application instead of deleting it", map[string]interface{}{
+		"application_key": applicationKey,
+	})
+	_, err = r.client.Applications().Update(ctx, applicationKey, client.ApplicationUpdate{
+		ProjectKey:    current.ProjectKey,
+		MaturityLevel: &maturityLevel,
+		Labels:        labels,
+		UserOwners:    userOwners,
+		GroupOwners:   groupOwners,
+	})
+	if err != nil {
+		diags.Append(apptrust.HandleClientError(err, "archive", "application")...)
+	}
+	return diags
+}
+
+// deleteContents deletes the versions and unbinds the bound packages of an application, so the
+// application itself can be deleted.
+func (r *ApplicationResource) deleteContents(ctx context.Context, applicationKey string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	versions, err := r.client.Versions().ListAll(ctx, applicationKey, client.VersionListOptions{})
+	if err != nil {
+		return apptrust.HandleClientError(err, "list", "application version")
+	}
+	tflog.Info(ctx, "Force destroy: deleting application versions", map[string]interface{}{
+		"application_key": applicationKey,
+		"count":           len(versions),
+	})
+	var mu sync.Mutex
+	deleted := 0
+	forEachConcurrently(versions, func(version client.Version) {
+		err := r.client.Versions().Delete(ctx, applicationKey, version.Version)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil && !client.IsNotFound(err) {
+			diags.Append(apptrust.HandleClientError(err, "delete", "application version "+version.Version)...)
+			return
+		}
+		deleted++
+		tflog.Info(ctx, "Force destroy: deleted application version", map[string]interface{}{
 			"application_key": applicationKey,
+			"version":         version.Version,
+			"progress":        fmt.Sprintf("%d/%d", deleted, len(versions)),
 		})
-		return
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	packages, err := r.client.Packages().ListAll(ctx, applicationKey, client.PackageListOptions{})
+	if err != nil {
+		return apptrust.HandleClientError(err, "list", "bound package")
+	}
+	var bindings []apptrust.BoundPackageID
+	for _, pkg := range packages {
+		packageVersions, err := r.client.Packages().ListAllVersions(ctx, applicationKey, pkg.Type, pkg.Name, client.PackageVersionListOptions{})
+		if err != nil {
+			return apptrust.HandleClientError(err, "list", "bound package")
+		}
+		for _, packageVersion := range packageVersions {
+			bindings = append(bindings, apptrust.BoundPackageID{
+				ApplicationKey: applicationKey,
+				PackageType:    pkg.Type,
+				PackageName:    pkg.Name,
+				PackageVersion: packageVersion.Version,
+			})
+		}
 	}
-	errorDiags := apptrust.HandleAPIError(httpResponse, "delete")
-	resp.Diagnostics.Append(errorDiags...)
+	tflog.Info(ctx, "Force destroy: unbinding packages", map[string]interface{}{
+		"application_key": applicationKey,
+		"count":           len(bindings),
+	})
+	unbound := 0
+	forEachConcurrently(bindings, func(binding apptrust.BoundPackageID) {
+		err := r.client.Packages().Unbind(ctx, applicationKey, binding.PackageType, binding.PackageName, binding.PackageVersion)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil && !client.IsNotFound(err) {
+			diags.Append(apptrust.HandleClientError(err, "unbind", "bound package "+binding.String())...)
+			return
+		}
+		unbound++
+		tflog.Info(ctx, "Force destroy: unbound package", map[string]interface{}{
+			"id":       binding.String(),
+			"progress": fmt.Sprintf("%d/%d", unbound, len(bindings)),
+		})
+	})
+	return diags
+}
+
+// forEachConcurrently calls fn for every item, at most forceDestroyConcurrency at a time, and
+// returns once all calls returned. The requests fn sends are further throttled by the provider
+// max_concurrent_requests and requests_per_second limiter, which wraps the client transport.
+func forEachConcurrently[T any](items []T, fn func(T)) {
+	sem := make(chan struct{}, forceDestroyConcurrency)
+	var wg sync.WaitGroup
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(item)
+		}()
+	}
+	wg.Wait()
 }
 
-func (m *ApplicationResourceModel) toAPIModel(ctx context.Context) (ApplicationAPIModel, diag.Diagnostics) {
+// toAPIModel builds the create request. The labels sent are the resource labels merged over defaultLabels.
+func (m *ApplicationResourceModel) toAPIModel(ctx context.Context, defaultLabels map[string]string) (client.Application, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	apiModel := ApplicationAPIModel{
+	apiModel := client.Application{
 		ApplicationKey:  m.ApplicationKey.ValueString(),
 		ApplicationName: m.ApplicationName.ValueString(),
 		ProjectKey:      m.ProjectKey.ValueString(),
@@ -606,12 +793,13 @@ func (m *ApplicationResourceModel) toAPIModel(ctx context.Context) (ApplicationA
 		apiModel.Criticality = m.Criticality.ValueString()
 	}
 
+	labels := make(map[string]string)
 	if !m.Labels.IsNull() {
-		labels := make(map[string]string)
 		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
-		if !diags.HasError() {
-			apiModel.Labels = labels
-		}
+	}
+	if !diags.HasError() {
+		// Empty labels are omitted from the create request.
+		apiModel.Labels = apptrust.MergeLabels(defaultLabels, labels)
 	}
 
 	if !m.UserOwners.IsNull() {
@@ -633,9 +821,11 @@ func (m *ApplicationResourceModel) toAPIModel(ctx context.Context) (ApplicationA
 	return apiModel, diags
 }
 
-func (m *ApplicationResourceModel) toAPIModelForUpdate(ctx context.Context) (UpdateApplicationAPIModel, diag.Diagnostics) {
+// toAPIModelForUpdate builds the PATCH request. Labels are always sent as the resource labels merged
+// over defaultLabels, so removed labels and changed provider defaults are applied; an empty map clears them.
+func (m *ApplicationResourceModel) toAPIModelForUpdate(ctx context.Context, defaultLabels map[string]string) (client.ApplicationUpdate, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	apiModel := UpdateApplicationAPIModel{}
+	apiModel := client.ApplicationUpdate{}
 
 	// Use pointers to differentiate between null (don't update) and empty string (clear field)
 	// Optional string fields use *string
@@ -659,12 +849,12 @@ func (m *ApplicationResourceModel) toAPIModelForUpdate(ctx context.Context) (Upd
 		apiModel.Criticality = &val
 	}
 
+	labels := make(map[string]string)
 	if !m.Labels.IsNull() {
-		labels := make(map[string]string)
 		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
-		if !diags.HasError() {
-			apiModel.Labels = labels
-		}
+	}
+	if !diags.HasError() {
+		apiModel.Labels = apptrust.MergeLabels(defaultLabels, labels)
 	}
 
 	if !m.UserOwners.IsNull() {
@@ -686,7 +876,9 @@ func (m *ApplicationResourceModel) toAPIModelForUpdate(ctx context.Context) (Upd
 	return apiModel, diags
 }
 
-func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api ApplicationAPIModel) diag.Diagnostics {
+// fromAPIModel sets the model from the API application. labels_all holds every label returned by the API,
+// while labels leaves out the provider default labels, unless the previous labels set the same key.
+func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api client.Application, defaultLabels map[string]string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Set ID to application_key for Terraform compatibility
@@ -694,6 +886,14 @@ func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api Applica
 	m.ApplicationKey = types.StringValue(api.ApplicationKey)
 	m.ApplicationName = types.StringValue(api.ApplicationName)
 	m.ProjectKey = types.StringValue(api.ProjectKey)
+	// force_destroy and destroy_behavior are not part of the API model. They are null after an
+	// import or in list results.
+	if m.ForceDestroy.IsNull() {
+		m.ForceDestroy = types.BoolValue(false)
+	}
+	if m.DestroyBehavior.IsNull() {
+		m.DestroyBehavior = types.StringValue(destroyBehaviorDelete)
+	}
 
 	if api.Description != "" {
 		m.Description = types.StringValue(api.Description)
@@ -701,6 +901,15 @@ func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api Applica
 		m.Description = types.StringNull()
 	}
 
+	// Audit metadata is computed only, so refreshing it never causes a diff. A field the API
+	// leaves out is stored as null rather than an empty string or zero.
+	m.Created = types.StringPointerValue(api.Created)
+	m.CreatedBy = types.StringPointerValue(api.CreatedBy)
+	m.Modified = types.StringPointerValue(api.Modified)
+	m.ModifiedBy = types.StringPointerValue(api.ModifiedBy)
+	m.VersionsCount = types.Int64PointerValue(api.VersionsCount)
+	m.LatestVersion = types.StringPointerValue(api.LatestVersion)
+
 	// Normalize empty to default so state never has null (schema default is "unspecified")
 	if api.MaturityLevel != "" {
 		m.MaturityLevel = types.StringValue(api.MaturityLevel)
@@ -713,12 +922,24 @@ func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api Applica
 		m.Criticality = types.StringValue("unspecified")
 	}
 
-	if len(api.Labels) > 0 {
-		labels := make(map[string]types.String)
-		for k, v := range api.Labels {
-			labels[k] = types.StringValue(v)
+	labelsAll := make(map[string]types.String)
+	ownLabels := make(map[string]types.String)
+	for k, v := range api.Labels {
+		labelsAll[k] = types.StringValue(v)
+		if defaultValue, isDefault := defaultLabels[k]; isDefault && defaultValue == v {
+			if _, configured := m.Labels.Elements()[k]; !configured {
+				continue
+			}
 		}
-		labelsMap, d := types.MapValueFrom(ctx, types.StringType, labels)
+		ownLabels[k] = types.StringValue(v)
+	}
+	labelsAllMap, d := types.MapValueFrom(ctx, types.StringType, labelsAll)
+	diags.Append(d...)
+	if !diags.HasError() {
+		m.LabelsAll = labelsAllMap
+	}
+	if len(ownLabels) > 0 {
+		labelsMap, d := types.MapValueFrom(ctx, types.StringType, ownLabels)
 		diags.Append(d...)
 		if !diags.HasError() {
 			m.Labels = labelsMap
@@ -728,19 +949,19 @@ func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api Applica
 	}
 
 	// API BEHAVIOR: No owners is represented as null in state (API omits or returns []).
-	// Empty list [] is preserved when plan/state had [] and API returns nothing (see Create/Read/Update).
+	// An empty set [] is preserved when plan/state had [] and API returns nothing (see Create/Read/Update).
 	if api.UserOwners != nil && len(api.UserOwners) > 0 {
 		userOwners := make([]types.String, len(api.UserOwners))
 		for i, v := range api.UserOwners {
 			userOwners[i] = types.StringValue(v)
 		}
-		userOwnersList, d := types.ListValueFrom(ctx, types.StringType, userOwners)
+		userOwnersSet, d := types.SetValueFrom(ctx, types.StringType, userOwners)
 		diags.Append(d...)
 		if !diags.HasError() {
-			m.UserOwners = userOwnersList
+			m.UserOwners = userOwnersSet
 		}
 	} else {
-		m.UserOwners = types.ListNull(types.StringType)
+		m.UserOwners = types.SetNull(types.StringType)
 	}
 
 	if api.GroupOwners != nil && len(api.GroupOwners) > 0 {
@@ -748,20 +969,222 @@ func (m *ApplicationResourceModel) fromAPIModel(ctx context.Context, api Applica
 		for i, v := range api.GroupOwners {
 			groupOwners[i] = types.StringValue(v)
 		}
-		groupOwnersList, d := types.ListValueFrom(ctx, types.StringType, groupOwners)
+		groupOwnersSet, d := types.SetValueFrom(ctx, types.StringType, groupOwners)
 		diags.Append(d...)
 		if !diags.HasError() {
-			m.GroupOwners = groupOwnersList
+			m.GroupOwners = groupOwnersSet
 		}
 	} else {
-		m.GroupOwners = types.ListNull(types.StringType)
+		m.GroupOwners = types.SetNull(types.StringType)
 	}
 
 	return diags
 }
 
-// ImportState imports an existing application using the application_key as the import ID.
+// applicationResourceModelV0 is the state of schema version 0, with user_owners and group_owners as lists.
+type applicationResourceModelV0 struct {
+	ID              types.String `tfsdk:"id"`
+	ApplicationKey  types.String `tfsdk:"application_key"`
+	ApplicationName types.String `tfsdk:"application_name"`
+	ProjectKey      types.String `tfsdk:"project_key"`
+	Description     types.String `tfsdk:"description"`
+	MaturityLevel   types.String `tfsdk:"maturity_level"`
+	Criticality     types.String `tfsdk:"criticality"`
+	Labels          types.Map    `tfsdk:"labels"`
+	UserOwners      types.List   `tfsdk:"user_owners"`
+	GroupOwners     types.List   `tfsdk:"group_owners"`
+}
+
+// applicationSchemaV0 is the schema of version 0, as released in 1.0.0. It must not follow
+// changes to the current schema. Only the attribute types matter to read a prior state, so the
+// descriptions, defaults and validators are left out.
+var applicationSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"application_key": schema.StringAttribute{
+			Required: true,
+		},
+		"application_name": schema.StringAttribute{
+			Required: true,
+		},
+		"project_key": schema.StringAttribute{
+			Required: true,
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+		},
+		"maturity_level": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"criticality": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"labels": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"user_owners": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"group_owners": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	},
+}
+
+// UpgradeState migrates the states of schema version 0. The owners lists become sets, so the
+// upgraded state matches the configuration without a plan. The attributes added since are set to
+// their defaults, or left null for the next refresh to read.
+func (r *ApplicationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &applicationSchemaV0,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior applicationResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				userOwners, diags := ownersSetFromList(ctx, prior.UserOwners)
+				resp.Diagnostics.Append(diags...)
+				groupOwners, diags := ownersSetFromList(ctx, prior.GroupOwners)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := ApplicationResourceModel{
+					ID:              prior.ID,
+					ApplicationKey:  prior.ApplicationKey,
+					ApplicationName: prior.ApplicationName,
+					ProjectKey:      prior.ProjectKey,
+					Description:     prior.Description,
+					MaturityLevel:   prior.MaturityLevel,
+					Criticality:     prior.Criticality,
+					Labels:          prior.Labels,
+					// Version 0 had no default labels, so every label of the application was in labels.
+					LabelsAll:       prior.Labels,
+					UserOwners:      userOwners,
+					GroupOwners:     groupOwners,
+					ForceDestroy:    types.BoolValue(false),
+					DestroyBehavior: types.StringValue(destroyBehaviorDelete),
+				}
+				if upgraded.LabelsAll.IsNull() {
+					upgraded.LabelsAll = types.MapValueMust(types.StringType, map[string]attr.Value{})
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
+// ownersSetFromList converts an owners list of a version 0 state to a set, dropping duplicates.
+func ownersSetFromList(ctx context.Context, owners types.List) (types.Set, diag.Diagnostics) {
+	if owners.IsNull() || owners.IsUnknown() {
+		return types.SetNull(types.StringType), nil
+	}
+
+	var values []string
+	diags := owners.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, unique)
+	diags.Append(d...)
+	return set, diags
+}
+
+// ModifyPlan applies the provider defaults. project_key falls back to the provider project key and the
+// application is replaced when the effective key changes. labels_all is planned as the resource labels
+// merged over the provider default labels, so plans show the effective labels and a change of the
+// provider defaults, or drift on them, updates the application.
+func (r *ApplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var projectKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_key"), &projectKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if projectKey.IsNull() {
+		if r.ProviderData.ProjectKey == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_key"),
+				"Missing project_key",
+				"project_key must be set on the resource or as the provider project_key.",
+			)
+			return
+		}
+		projectKey = types.StringValue(r.ProviderData.ProjectKey)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_key"), projectKey)...)
+	}
+	if !req.State.Raw.IsNull() {
+		var stateProjectKey types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_key"), &stateProjectKey)...)
+		if !projectKey.Equal(stateProjectKey) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("project_key"))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if labels.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	ownLabels := make(map[string]string)
+	for k, v := range labels.Elements() {
+		value, ok := v.(types.String)
+		if !ok || value.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), types.MapUnknown(types.StringType))...)
+			return
+		}
+		ownLabels[k] = value.ValueString()
+	}
+
+	labelsAll, diags := types.MapValueFrom(ctx, types.StringType, apptrust.MergeLabels(r.ProviderData.DefaultLabels, ownLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels_all"), labelsAll)...)
+}
+
+// ImportState imports an existing application using the application_key as the import ID, or
+// the application_key of the identity.
 // Example: terraform import apptrust_application.example my-application-key
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("application_key"), req, resp)
+}
+
+// identity returns the resource identity of m.
+func (m *ApplicationResourceModel) identity() ApplicationIdentityModel {
+	return ApplicationIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+	}
 }
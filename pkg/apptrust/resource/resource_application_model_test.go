@@ -0,0 +1,52 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
+)
+
+func TestApplicationResourceModel_fromAPIModel_auditMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	var omitted ApplicationResourceModel
+	if diags := omitted.fromAPIModel(ctx, client.Application{ApplicationKey: "my-app"}, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	for name, isNull := range map[string]bool{
+		"created":        omitted.Created.IsNull(),
+		"created_by":     omitted.CreatedBy.IsNull(),
+		"modified":       omitted.Modified.IsNull(),
+		"modified_by":    omitted.ModifiedBy.IsNull(),
+		"versions_count": omitted.VersionsCount.IsNull(),
+		"latest_version": omitted.LatestVersion.IsNull(),
+	} {
+		if !isNull {
+			t.Errorf("expected %s omitted by the API to be null", name)
+		}
+	}
+
+	created, versionsCount := "2025-01-01T00:00:00Z", int64(0)
+	var returned ApplicationResourceModel
+	if diags := returned.fromAPIModel(ctx, client.Application{ApplicationKey: "my-app", Created: &created, VersionsCount: &versionsCount}, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if returned.Created.ValueString() != created || returned.VersionsCount.IsNull() || returned.VersionsCount.ValueInt64() != 0 {
+		t.Errorf("expected the returned audit metadata, got %s and %s", returned.Created, returned.VersionsCount)
+	}
+}
@@ -17,12 +17,16 @@ package resource_test
 import (
 	"fmt"
 	"net/http"
+	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -67,6 +71,63 @@ func TestAccApplication_basic(t *testing.T) {
 	})
 }
 
+// TestAccApplication_auditMetadata checks the computed audit metadata, and that a new version
+// changing versions_count and latest_version does not cause a diff.
+func TestAccApplication_auditMetadata(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-", "apptrust_application")
+	_, versionFqrn, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+
+	application := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "app-%d"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+	`, name, id, name, acctest.AppTrustProjectKey1)
+
+	version := fmt.Sprintf(`
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "1.0.0"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+	`, versionName, name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationVersionDestroy(versionFqrn),
+			testAccCheckApplicationDestroy(fqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: application,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "created"),
+					resource.TestCheckResourceAttrSet(fqrn, "created_by"),
+					resource.TestCheckResourceAttrSet(fqrn, "modified"),
+					resource.TestCheckResourceAttrSet(fqrn, "modified_by"),
+					resource.TestCheckResourceAttr(fqrn, "versions_count", "0"),
+				),
+			},
+			{
+				Config: application + version,
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "versions_count", "1"),
+					resource.TestCheckResourceAttr(fqrn, "latest_version", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplication_full(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -133,9 +194,9 @@ func TestAccApplication_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "labels.region", "us-east-1"),
 					resource.TestCheckResourceAttr(fqrn, "labels.team", "platform"),
 					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "user_owners.0", "admin"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "admin"),
 					resource.TestCheckResourceAttr(fqrn, "group_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "group_owners.0", "readers"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "readers"),
 				),
 			},
 			{
@@ -386,6 +447,143 @@ func TestAccApplication_labels(t *testing.T) {
 	})
 }
 
+func TestAccApplication_defaultLabels(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-default-labels-", "apptrust_application")
+	projectKey := acctest.AppTrustProjectKey1
+
+	config := func(team string) string {
+		return fmt.Sprintf(`
+			provider "apptrust" {
+				default_labels = {
+					managed-by = "terraform"
+					team       = "%s"
+				}
+			}
+
+			resource "apptrust_application" "%s" {
+				application_key  = "app-%d"
+				application_name = "%s"
+				project_key      = "%s"
+				labels = {
+					team = "qa"
+					tier = "backend"
+				}
+			}
+		`, team, name, id, name, projectKey)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config("platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "labels.%", "2"),
+					resource.TestCheckResourceAttr(fqrn, "labels.team", "qa"),
+					resource.TestCheckResourceAttr(fqrn, "labels.tier", "backend"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.%", "3"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.managed-by", "terraform"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.team", "qa"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.tier", "backend"),
+				),
+			},
+			{
+				Config: config("security"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`
+					provider "apptrust" {
+						default_labels = {
+							managed-by = "terraform-cloud"
+						}
+					}
+
+					resource "apptrust_application" "%s" {
+						application_key  = "app-%d"
+						application_name = "%s"
+						project_key      = "%s"
+					}
+				`, name, id, name, projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(fqrn, "labels"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.%", "1"),
+					resource.TestCheckResourceAttr(fqrn, "labels_all.managed-by", "terraform-cloud"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplication_providerProjectKey(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-provider-project-", "apptrust_application")
+
+	config := func(providerProjectKey, resourceProjectKey string) string {
+		projectKeyAttr := ""
+		if resourceProjectKey != "" {
+			projectKeyAttr = fmt.Sprintf("project_key = %q", resourceProjectKey)
+		}
+		return fmt.Sprintf(`
+			provider "apptrust" {
+				project_key = "%s"
+			}
+
+			resource "apptrust_application" "%s" {
+				application_key  = "app-%d"
+				application_name = "%s"
+				%s
+			}
+		`, providerProjectKey, name, id, name, projectKeyAttr)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config(acctest.AppTrustProjectKey1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_key", acctest.AppTrustProjectKey1),
+				),
+			},
+			{
+				// Setting the same key on the resource keeps the application.
+				Config: config(acctest.AppTrustProjectKey2, acctest.AppTrustProjectKey1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Changing the effective project key replaces the application.
+				Config: config(acctest.AppTrustProjectKey2, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "project_key", acctest.AppTrustProjectKey2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplication_owners(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -455,7 +653,7 @@ func TestAccApplication_owners(t *testing.T) {
 				Config: config2,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "user_owners.0", "admin"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "admin"),
 					resource.TestCheckNoResourceAttr(fqrn, "group_owners"),
 				),
 			},
@@ -464,7 +662,7 @@ func TestAccApplication_owners(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "1"),
 					resource.TestCheckResourceAttr(fqrn, "group_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "group_owners.0", "readers"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "readers"),
 				),
 			},
 			{
@@ -485,6 +683,105 @@ func TestAccApplication_owners(t *testing.T) {
 	})
 }
 
+// TestAccApplication_ownersOrder checks that reordering owners in the configuration does not change
+// the plan, as owners are sets.
+func TestAccApplication_ownersOrder(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-owners-", "apptrust_application")
+	projectKey := acctest.AppTrustProjectKey1
+
+	config := func(userOwners, groupOwners string) string {
+		return fmt.Sprintf(`
+			resource "apptrust_application" "%s" {
+				application_key  = "app-%d"
+				application_name = "%s"
+				project_key      = "%s"
+				user_owners      = %s
+				group_owners     = %s
+			}
+		`, name, id, name, projectKey, userOwners, groupOwners)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["test-user", "admin"]`, `["readers", "developers"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "admin"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "test-user"),
+					resource.TestCheckResourceAttr(fqrn, "group_owners.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "developers"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "readers"),
+				),
+			},
+			{
+				Config: config(`["admin", "test-user"]`, `["developers", "readers"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestAccApplication_upgradeFromListOwners checks that states written with owners as lists (schema
+// version 0) are upgraded without a plan.
+func TestAccApplication_upgradeFromListOwners(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-upgrade-", "apptrust_application")
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "app-%d"
+			application_name = "%s"
+			project_key      = "%s"
+			user_owners      = ["admin", "test-user"]
+			group_owners     = ["readers"]
+		}
+	`, name, id, name, acctest.AppTrustProjectKey1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		CheckDestroy: testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"apptrust": {
+						Source:            "jfrog/apptrust",
+						VersionConstraint: "1.0.0",
+					},
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "user_owners.#", "2"),
+			},
+			{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "test-user"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "readers"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccApplication_maturityLevels(t *testing.T) {
 	acctest.SkipIfNotAcc(t)
 	acctest.PreCheck(t)
@@ -1057,9 +1354,9 @@ func TestAccApplication_nullAndOmittedValues(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "description", "test description"),
 					resource.TestCheckResourceAttr(fqrn, "labels.env", "test"),
 					resource.TestCheckResourceAttr(fqrn, "user_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "user_owners.0", "admin"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "user_owners.*", "admin"),
 					resource.TestCheckResourceAttr(fqrn, "group_owners.#", "1"),
-					resource.TestCheckResourceAttr(fqrn, "group_owners.0", "readers"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "group_owners.*", "readers"),
 				),
 			},
 			{
@@ -1371,6 +1668,208 @@ func TestAccApplication_conflict(t *testing.T) {
 	})
 }
 
+// TestAccApplication_forceDestroy destroys an application that still has a version and a bound
+// package, which are removed from the configuration without being destroyed first.
+func TestAccApplication_forceDestroy(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	pkgType := os.Getenv("APPTRUST_TEST_PACKAGE_TYPE")
+	pkgName := os.Getenv("APPTRUST_TEST_PACKAGE_NAME")
+	pkgVersion := os.Getenv("APPTRUST_TEST_PACKAGE_VERSION")
+	if pkgType == "" || pkgName == "" || pkgVersion == "" {
+		t.Skip("Set APPTRUST_TEST_PACKAGE_TYPE, APPTRUST_TEST_PACKAGE_NAME, APPTRUST_TEST_PACKAGE_VERSION to run force destroy acceptance test")
+	}
+
+	id, fqrn, name := testutil.MkNames("test-app-", "apptrust_application")
+	_, _, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+	_, _, pkgNameRes := testutil.MkNames("test-pkg-", "apptrust_bound_package")
+	appKey := fmt.Sprintf("app-%d", id)
+
+	application := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+			force_destroy    = true
+		}
+	`, name, appKey, name, acctest.AppTrustProjectKey1)
+
+	contents := fmt.Sprintf(`
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "1.0.0"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+		resource "apptrust_bound_package" "%s" {
+			application_key = apptrust_application.%s.application_key
+			package_type    = "%s"
+			package_name    = "%s"
+			package_version = "%s"
+		}
+	`, versionName, name, pkgNameRes, name, pkgType, pkgName, pkgVersion)
+
+	// Forget the version and the binding so only force_destroy can delete them.
+	removed := fmt.Sprintf(`
+		removed {
+			from = apptrust_application_version.%s
+			lifecycle {
+				destroy = false
+			}
+		}
+		removed {
+			from = apptrust_bound_package.%s
+			lifecycle {
+				destroy = false
+			}
+		}
+	`, versionName, pkgNameRes)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		CheckDestroy: testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: application + contents,
+				Check:  resource.TestCheckResourceAttr(fqrn, "force_destroy", "true"),
+			},
+			{
+				Config: application + removed,
+				Check:  resource.TestCheckResourceAttr(fqrn, "force_destroy", "true"),
+			},
+		},
+	})
+}
+
+func TestAccApplication_destroyBehaviorProtect(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-", "apptrust_application")
+
+	config := func(destroyBehavior string) string {
+		return fmt.Sprintf(`
+			resource "apptrust_application" "%s" {
+				application_key  = "app-%d"
+				application_name = "%s"
+				project_key      = "%s"
+				destroy_behavior = "%s"
+			}
+		`, name, id, name, acctest.AppTrustProjectKey1, destroyBehavior)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config("protect"),
+				Check:  resource.TestCheckResourceAttr(fqrn, "destroy_behavior", "protect"),
+			},
+			{
+				Config:      config("protect"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Application Is Protected`),
+			},
+			{
+				Config: config("delete"),
+				Check:  resource.TestCheckResourceAttr(fqrn, "destroy_behavior", "delete"),
+			},
+		},
+	})
+}
+
+func TestAccApplication_destroyBehaviorArchive(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-", "apptrust_application")
+
+	config := fmt.Sprintf(`
+		provider "apptrust" {
+			default_labels = {
+				managed-by = "terraform"
+			}
+		}
+
+		resource "apptrust_application" "%s" {
+			application_key  = "app-%d"
+			application_name = "%s"
+			project_key      = "%s"
+			destroy_behavior = "archive"
+			labels = {
+				env = "test"
+			}
+		}
+	`, name, id, name, acctest.AppTrustProjectKey1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationArchived(fqrn),
+			testAccCheckApplicationDestroy(fqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "destroy_behavior", "archive"),
+					resource.TestCheckResourceAttr(fqrn, "labels.managed-by", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckApplicationArchived checks that an application with destroy_behavior = "archive" still
+// exists after destroy, at the end_of_life maturity level and without the Terraform ownership label.
+func testAccCheckApplicationArchived(id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: Resource id [%s] not found", id)
+		}
+		applicationKey := rs.Primary.Attributes["application_key"]
+
+		client, err := acctest.GetTestRestyFromEnv()
+		if err != nil {
+			return fmt.Errorf("error creating resty client: %w", err)
+		}
+
+		var application struct {
+			MaturityLevel string            `json:"maturity_level"`
+			Labels        map[string]string `json:"labels"`
+		}
+		response, err := client.R().
+			SetPathParam("application_key", applicationKey).
+			SetResult(&application).
+			Get(applicationEndpoint + "/{application_key}")
+		if err != nil {
+			return err
+		}
+		if response.IsError() {
+			return fmt.Errorf("error: archived application %s not found: %s", applicationKey, response.String())
+		}
+
+		if application.MaturityLevel != "end_of_life" {
+			return fmt.Errorf("error: expected maturity_level end_of_life for archived application %s, got %s", applicationKey, application.MaturityLevel)
+		}
+		if _, ok := application.Labels["managed-by"]; ok {
+			return fmt.Errorf("error: expected the managed-by label to be removed from archived application %s", applicationKey)
+		}
+		if application.Labels["env"] != "test" {
+			return fmt.Errorf("error: expected the env label to be kept on archived application %s, got %v", applicationKey, application.Labels)
+		}
+		return nil
+	}
+}
+
 func testAccCheckApplicationDestroy(id string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -1431,3 +1930,44 @@ func testAccCheckApplicationDestroy(id string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// TestAccApplication_identity checks the resource identity of an application and its import by identity.
+func TestAccApplication_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, fqrn, name := testutil.MkNames("test-app-identity-", "apptrust_application")
+	appKey := fmt.Sprintf("app-%d", id)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+	`, name, appKey, name, acctest.AppTrustProjectKey1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: testAccCheckApplicationDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(fqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+					}),
+				},
+			},
+			{
+				ResourceName:    fqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestApplicationUpgradeState_v0(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	upgrader := r.UpgradeState(ctx)[0]
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx).(tftypes.Object)
+	for _, name := range []string{"labels_all", "force_destroy", "destroy_behavior", "created"} {
+		if _, ok := priorType.AttributeTypes[name]; ok {
+			t.Errorf("expected %s, added after version 0, not to be in the prior schema", name)
+		}
+	}
+	prior := make(map[string]tftypes.Value, len(priorType.AttributeTypes))
+	for name, attrType := range priorType.AttributeTypes {
+		prior[name] = tftypes.NewValue(attrType, nil)
+	}
+	stringList := tftypes.List{ElementType: tftypes.String}
+	prior["id"] = tftypes.NewValue(tftypes.String, "my-app")
+	prior["application_key"] = tftypes.NewValue(tftypes.String, "my-app")
+	prior["application_name"] = tftypes.NewValue(tftypes.String, "My App")
+	prior["labels"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"team": tftypes.NewValue(tftypes.String, "payments"),
+	})
+	prior["user_owners"] = tftypes.NewValue(stringList, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "bob"),
+		tftypes.NewValue(tftypes.String, "alice"),
+		tftypes.NewValue(tftypes.String, "bob"),
+	})
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: *upgrader.PriorSchema,
+			Raw:    tftypes.NewValue(priorType, prior),
+		},
+	}
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state ApplicationResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var userOwners []string
+	if diags := state.UserOwners.ElementsAs(ctx, &userOwners, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(userOwners) != 2 {
+		t.Errorf("expected the duplicate owner to be dropped, got %v", userOwners)
+	}
+	if !state.GroupOwners.IsNull() {
+		t.Errorf("expected null group_owners, got %s", state.GroupOwners)
+	}
+	if state.ApplicationKey.ValueString() != "my-app" || state.ApplicationName.ValueString() != "My App" {
+		t.Errorf("unexpected state %+v", state)
+	}
+	if !state.LabelsAll.Equal(state.Labels) {
+		t.Errorf("expected labels_all to hold the labels, got %s", state.LabelsAll)
+	}
+	if !state.Created.IsNull() || !state.VersionsCount.IsNull() {
+		t.Errorf("expected the audit metadata to be left for the next refresh, got %s and %s", state.Created, state.VersionsCount)
+	}
+	if state.ForceDestroy.ValueBool() || state.DestroyBehavior.ValueString() != destroyBehaviorDelete {
+		t.Errorf("expected the force_destroy and destroy_behavior defaults, got %s and %s", state.ForceDestroy, state.DestroyBehavior)
+	}
+}
@@ -16,31 +16,22 @@ package resource
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
-)
-
-const (
-	ApplicationVersionsEndpoint    = ApplicationEndpoint + "/versions"
-	ApplicationVersionEndpoint     = ApplicationVersionsEndpoint + "/{version}"
-	ApplicationVersionPromoteEP    = ApplicationVersionEndpoint + "/promote"
-	ApplicationVersionReleaseEP    = ApplicationVersionEndpoint + "/release"
-	ApplicationVersionRollbackEP   = ApplicationVersionEndpoint + "/rollback"
-	ApplicationVersionStatusEP     = ApplicationVersionEndpoint + "/status"
-	ApplicationVersionPromotionsEP = ApplicationVersionEndpoint + "/promotions"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &ApplicationVersionResource{}
+var _ resource.ResourceWithIdentity = &ApplicationVersionResource{}
+var _ resource.ResourceWithMoveState = &ApplicationVersionResource{}
 
 func NewApplicationVersionResource() resource.Resource {
 	return &ApplicationVersionResource{
@@ -49,8 +40,9 @@ func NewApplicationVersionResource() resource.Resource {
 }
 
 type ApplicationVersionResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type ApplicationVersionResourceModel struct {
@@ -69,51 +61,21 @@ type ApplicationVersionResourceModel struct {
 	CurrentStage  types.String `tfsdk:"current_stage"`
 }
 
-type applicationVersionSourceArtifact struct {
-	Path   string `json:"path"`
-	Sha256 string `json:"sha256,omitempty"`
-}
-
-type applicationVersionSourceBuild struct {
-	Name                string `json:"name"`
-	Number              string `json:"number"`
-	IncludeDependencies bool   `json:"include_dependencies,omitempty"`
-	RepositoryKey       string `json:"repository_key,omitempty"`
-	Started             string `json:"started,omitempty"`
-}
-
-type createApplicationVersionBody struct {
-	Version string                          `json:"version"`
-	Sources createApplicationVersionSources `json:"sources"`
-	Tag     string                          `json:"tag,omitempty"`
-}
-
-type applicationVersionSourceVersion struct {
-	ApplicationKey string `json:"application_key"`
-	Version        string `json:"version"`
-}
-
-type createApplicationVersionSources struct {
-	Artifacts []applicationVersionSourceArtifact `json:"artifacts,omitempty"`
-	Builds    []applicationVersionSourceBuild    `json:"builds,omitempty"`
-	Versions  []applicationVersionSourceVersion  `json:"versions,omitempty"`
+// ApplicationVersionIdentityModel identifies an application version.
+type ApplicationVersionIdentityModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Version        types.String `tfsdk:"version"`
 }
 
-type applicationVersionListItem struct {
-	Version       string `json:"version"`
-	Tag           string `json:"tag"`
-	Status        string `json:"status"`
-	ReleaseStatus string `json:"release_status"`
-	CurrentStage  string `json:"current_stage"`
-	CreatedBy     string `json:"created_by"`
-	Created       string `json:"created"`
-}
-
-type applicationVersionsListResponse struct {
-	Versions []applicationVersionListItem `json:"versions"`
-	Total    int                          `json:"total"`
-	Limit    int                          `json:"limit"`
-	Offset   int                          `json:"offset"`
+// applicationVersionFieldPaths maps the fields of application version request errors to the attributes they are sent from.
+var applicationVersionFieldPaths = apptrust.FieldPaths{
+	"version":           {Path: path.Root("version")},
+	"tag":               {Path: path.Root("tag")},
+	"sources.artifacts": {Path: path.Root("source_artifacts")},
+	"sources.builds":    {Path: path.Root("source_builds")},
+	"sources.versions":  {Path: path.Root("source_versions")},
+	"properties":        {Path: path.Root("properties"), MapKeys: true},
+	"delete_properties": {Path: path.Root("delete_properties")},
 }
 
 func (r *ApplicationVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -232,15 +194,31 @@ func (r *ApplicationVersionResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+func (r *ApplicationVersionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
+			"version": identityschema.StringAttribute{
+				Description:       "The application version.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApplicationVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan ApplicationVersionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -248,7 +226,7 @@ func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	sources := createApplicationVersionSources{}
+	sources := client.VersionSources{}
 	hasAnySource := false
 	if !plan.SourceArtifacts.IsNull() && !plan.SourceArtifacts.IsUnknown() {
 		var list []struct {
@@ -264,7 +242,7 @@ func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.Cr
 			if !e.Sha256.IsNull() {
 				sha256 = e.Sha256.ValueString()
 			}
-			sources.Artifacts = append(sources.Artifacts, applicationVersionSourceArtifact{Path: e.Path, Sha256: sha256})
+			sources.Artifacts = append(sources.Artifacts, client.VersionSourceArtifact{Path: e.Path, Sha256: sha256})
 		}
 		hasAnySource = len(sources.Artifacts) > 0
 	}
@@ -293,7 +271,7 @@ func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.Cr
 			if !e.Started.IsNull() {
 				started = e.Started.ValueString()
 			}
-			sources.Builds = append(sources.Builds, applicationVersionSourceBuild{
+			sources.Builds = append(sources.Builds, client.VersionSourceBuild{
 				Name:                e.Name,
 				Number:              e.Number,
 				IncludeDependencies: includeDeps,
@@ -313,7 +291,7 @@ func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.Cr
 			return
 		}
 		for _, e := range list {
-			sources.Versions = append(sources.Versions, applicationVersionSourceVersion{ApplicationKey: e.ApplicationKey, Version: e.Version})
+			sources.Versions = append(sources.Versions, client.VersionSourceVersion{ApplicationKey: e.ApplicationKey, Version: e.Version})
 		}
 		hasAnySource = hasAnySource || len(sources.Versions) > 0
 	}
@@ -325,40 +303,30 @@ func (r *ApplicationVersionResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	body := createApplicationVersionBody{
+	body := client.CreateVersionRequest{
 		Version: plan.Version.ValueString(),
 		Sources: sources,
 		Tag:     plan.Tag.ValueString(),
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetBody(body).
-		Post(ApplicationVersionsEndpoint)
-
+	err := r.client.Versions().Create(ctx, plan.ApplicationKey.ValueString(), body)
 	if err != nil {
 		tflog.Error(ctx, "Failed to create application version", map[string]interface{}{
 			"application_key": plan.ApplicationKey.ValueString(),
 			"version":         plan.Version.ValueString(),
 			"error":           err.Error(),
 		})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusCreated && httpResponse.StatusCode() != http.StatusAccepted {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "create", "application version")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "create", "application version", applicationVersionFieldPaths)...)
 		return
 	}
 
-	plan.ID = types.StringValue(plan.ApplicationKey.ValueString() + ":" + plan.Version.ValueString())
+	plan.ID = types.StringValue(apptrust.VersionID{ApplicationKey: plan.ApplicationKey.ValueString(), Version: plan.Version.ValueString()}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state ApplicationVersionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -369,15 +337,10 @@ func (r *ApplicationVersionResource) Read(ctx context.Context, req resource.Read
 	applicationKey := state.ApplicationKey.ValueString()
 	version := state.Version.ValueString()
 	if applicationKey == "" || version == "" {
-		if id := state.ID.ValueString(); id != "" {
-			// Import: id is "application_key:version"
-			for i, c := range id {
-				if c == ':' {
-					applicationKey = id[:i]
-					version = id[i+1:]
-					break
-				}
-			}
+		// Import: id is "application_key:version"
+		if id, err := apptrust.ParseVersionID(state.ID.ValueString()); err == nil {
+			applicationKey = id.ApplicationKey
+			version = id.Version
 		}
 	}
 	if applicationKey == "" || version == "" {
@@ -385,36 +348,16 @@ func (r *ApplicationVersionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	var listResp applicationVersionsListResponse
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetQueryParam("limit", "1000").
-		SetResult(&listResp).
-		Get(ApplicationVersionsEndpoint)
-
+	found, err := r.client.Versions().Find(ctx, applicationKey, version)
 	if err != nil {
-		utilfw.UnableToRefreshResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "application version")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "application version")...)
 		return
 	}
 
-	var found *applicationVersionListItem
-	for i := range listResp.Versions {
-		if listResp.Versions[i].Version == version {
-			found = &listResp.Versions[i]
-			break
-		}
-	}
 	if found == nil {
 		tflog.Warn(ctx, "Application version not found, removing from state", map[string]interface{}{
 			"application_key": applicationKey,
@@ -429,12 +372,13 @@ func (r *ApplicationVersionResource) Read(ctx context.Context, req resource.Read
 	state.Tag = types.StringValue(found.Tag)
 	state.ReleaseStatus = types.StringValue(found.ReleaseStatus)
 	state.CurrentStage = types.StringValue(found.CurrentStage)
-	state.ID = types.StringValue(applicationKey + ":" + version)
+	state.ID = types.StringValue(apptrust.VersionID{ApplicationKey: applicationKey, Version: version}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *ApplicationVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "UPDATE")
 
 	var plan ApplicationVersionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -442,8 +386,8 @@ func (r *ApplicationVersionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	body := map[string]interface{}{
-		"tag": plan.Tag.ValueString(),
+	body := client.UpdateVersionRequest{
+		Tag: plan.Tag.ValueString(),
 	}
 	if !plan.Properties.IsNull() && !plan.Properties.IsUnknown() {
 		props := make(map[string][]string)
@@ -459,40 +403,27 @@ func (r *ApplicationVersionResource) Update(ctx context.Context, req resource.Up
 			}
 			props[k] = strs
 		}
-		body["properties"] = props
+		body.Properties = props
 	}
 	if !plan.DeleteProperties.IsNull() && !plan.DeleteProperties.IsUnknown() {
-		var del []string
-		resp.Diagnostics.Append(plan.DeleteProperties.ElementsAs(ctx, &del, false)...)
+		resp.Diagnostics.Append(plan.DeleteProperties.ElementsAs(ctx, &body.DeleteProperties, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		body["delete_properties"] = del
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetPathParam("version", plan.Version.ValueString()).
-		SetBody(body).
-		Patch(ApplicationVersionEndpoint)
-
+	err := r.client.Versions().Update(ctx, plan.ApplicationKey.ValueString(), plan.Version.ValueString(), body)
 	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK && httpResponse.StatusCode() != http.StatusAccepted {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "update", "application version")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "update", "application version", applicationVersionFieldPaths)...)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 
 	var state ApplicationVersionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -503,46 +434,81 @@ func (r *ApplicationVersionResource) Delete(ctx context.Context, req resource.De
 	applicationKey := state.ApplicationKey.ValueString()
 	version := state.Version.ValueString()
 	if applicationKey == "" || version == "" {
-		id := state.ID.ValueString()
-		for i, c := range id {
-			if c == ':' {
-				applicationKey = id[:i]
-				version = id[i+1:]
-				break
-			}
+		if id, err := apptrust.ParseVersionID(state.ID.ValueString()); err == nil {
+			applicationKey = id.ApplicationKey
+			version = id.Version
 		}
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", applicationKey).
-		SetPathParam("version", version).
-		Delete(ApplicationVersionEndpoint)
-
-	if err != nil {
-		utilfw.UnableToDeleteResourceError(resp, err.Error())
+	err := r.client.Versions().Delete(ctx, applicationKey, version)
+	if err == nil || client.IsNotFound(err) {
 		return
 	}
+	resp.Diagnostics.Append(apptrust.HandleClientError(err, "delete", "application version")...)
+}
 
-	if httpResponse.StatusCode() != http.StatusNoContent && httpResponse.StatusCode() != http.StatusOK && httpResponse.StatusCode() != http.StatusAccepted {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+func (r *ApplicationVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: application_key:version. An import by identity has no ID.
+	var id apptrust.VersionID
+	if req.ID == "" {
+		var identity ApplicationVersionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "delete", "application version")
-		resp.Diagnostics.Append(errorDiags...)
+		id = apptrust.VersionID{
+			ApplicationKey: identity.ApplicationKey.ValueString(),
+			Version:        identity.Version.ValueString(),
+		}
+	} else {
+		parsed, err := apptrust.ParseVersionID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version (e.g. my-app:1.0.0)")
+			return
+		}
+		id = parsed
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), id.Version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 }
 
-func (r *ApplicationVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// ID format: application_key:version
-	id := req.ID
-	for i, c := range id {
-		if c == ':' {
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id[:i])...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), id[i+1:])...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
-			return
-		}
+// MoveState moves artifactory_release_bundle_v2 state of the JFrog Artifactory provider. The
+// release bundle name is the application key; the version is refreshed from the API afterwards.
+func (r *ApplicationVersionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &releaseBundleV2SourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isArtifactoryMove(req, releaseBundleV2TypeName) {
+					return
+				}
+				var source releaseBundleV2SourceModel
+				getMoveSourceState(ctx, req, &source, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				id := apptrust.VersionID{
+					ApplicationKey: source.Name.ValueString(),
+					Version:        source.Version.ValueString(),
+				}
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("version"), id.Version)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id.String())...)
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, ApplicationVersionIdentityModel{
+					ApplicationKey: source.Name,
+					Version:        source.Version,
+				})...)
+			},
+		},
+	}
+}
+
+// identity returns the resource identity of m.
+func (m *ApplicationVersionResourceModel) identity() ApplicationVersionIdentityModel {
+	return ApplicationVersionIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+		Version:        m.Version,
 	}
-	resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version (e.g. my-app:1.0.0)")
 }
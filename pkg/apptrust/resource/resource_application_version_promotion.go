@@ -16,22 +16,22 @@ package resource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &ApplicationVersionPromotionResource{}
+var _ resource.ResourceWithIdentity = &ApplicationVersionPromotionResource{}
+var _ resource.ResourceWithMoveState = &ApplicationVersionPromotionResource{}
 
 func NewApplicationVersionPromotionResource() resource.Resource {
 	return &ApplicationVersionPromotionResource{
@@ -40,8 +40,9 @@ func NewApplicationVersionPromotionResource() resource.Resource {
 }
 
 type ApplicationVersionPromotionResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type ApplicationVersionPromotionResourceModel struct {
@@ -55,19 +56,20 @@ type ApplicationVersionPromotionResourceModel struct {
 	PromotionAuthorizationType types.String `tfsdk:"promotion_authorization_type"`
 }
 
-// PromoteAppVersionRequest per OpenAPI request.PromoteAppVersionRequest
-type promoteAppVersionRequestBody struct {
-	TargetStage                  string                   `json:"target_stage"`
-	PromotionType                string                   `json:"promotion_type,omitempty"`
-	IncludedRepositoryKeys       []string                 `json:"included_repository_keys,omitempty"`
-	ExcludedRepositoryKeys       []string                 `json:"excluded_repository_keys,omitempty"`
-	ArtifactAdditionalProperties []artifactAdditionalProp `json:"artifact_additional_properties,omitempty"`
-	PromotionAuthorizationType   string                   `json:"promotion_authorization_type,omitempty"`
+// ApplicationVersionPromotionIdentityModel identifies the promotion of an application version to a stage.
+type ApplicationVersionPromotionIdentityModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Version        types.String `tfsdk:"version"`
+	TargetStage    types.String `tfsdk:"target_stage"`
 }
 
-type artifactAdditionalProp struct {
-	Key    string   `json:"key"`
-	Values []string `json:"values"`
+// applicationVersionPromotionFieldPaths maps the fields of promotion request errors to the attributes they are sent from.
+var applicationVersionPromotionFieldPaths = apptrust.FieldPaths{
+	"target_stage":                 {Path: path.Root("target_stage")},
+	"promotion_type":               {Path: path.Root("promotion_type")},
+	"included_repository_keys":     {Path: path.Root("included_repository_keys")},
+	"excluded_repository_keys":     {Path: path.Root("excluded_repository_keys")},
+	"promotion_authorization_type": {Path: path.Root("promotion_authorization_type")},
 }
 
 func (r *ApplicationVersionPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -128,19 +130,35 @@ func (r *ApplicationVersionPromotionResource) Schema(ctx context.Context, req re
 	}
 }
 
+func (r *ApplicationVersionPromotionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
+			"version": identityschema.StringAttribute{
+				Description:       "The promoted application version.",
+				RequiredForImport: true,
+			},
+			"target_stage": identityschema.StringAttribute{
+				Description:       "Target lifecycle stage.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApplicationVersionPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
-}
-
-func promotionID(appKey, version, targetStage string) string {
-	return fmt.Sprintf("%s:%s:%s", appKey, version, targetStage)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *ApplicationVersionPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan ApplicationVersionPromotionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -152,7 +170,7 @@ func (r *ApplicationVersionPromotionResource) Create(ctx context.Context, req re
 	if !plan.PromotionType.IsNull() && !plan.PromotionType.IsUnknown() {
 		promotionType = plan.PromotionType.ValueString()
 	}
-	body := promoteAppVersionRequestBody{
+	body := client.PromoteRequest{
 		TargetStage:   plan.TargetStage.ValueString(),
 		PromotionType: promotionType,
 	}
@@ -172,13 +190,7 @@ func (r *ApplicationVersionPromotionResource) Create(ctx context.Context, req re
 		}
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetPathParam("version", plan.Version.ValueString()).
-		SetBody(body).
-		Post(ApplicationVersionPromoteEP)
-
+	err := r.client.Promotions().Promote(ctx, plan.ApplicationKey.ValueString(), plan.Version.ValueString(), body)
 	if err != nil {
 		tflog.Error(ctx, "Failed to promote application version", map[string]interface{}{
 			"application_key": plan.ApplicationKey.ValueString(),
@@ -186,22 +198,21 @@ func (r *ApplicationVersionPromotionResource) Create(ctx context.Context, req re
 			"target_stage":    plan.TargetStage.ValueString(),
 			"error":           err.Error(),
 		})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "promote", "application version", applicationVersionPromotionFieldPaths)...)
 		return
 	}
 
-	if httpResponse.StatusCode() != http.StatusOK && httpResponse.StatusCode() != http.StatusAccepted {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "promote", "application version")
-		resp.Diagnostics.Append(errorDiags...)
-		return
-	}
-
-	plan.ID = types.StringValue(promotionID(plan.ApplicationKey.ValueString(), plan.Version.ValueString(), plan.TargetStage.ValueString()))
+	plan.ID = types.StringValue(apptrust.StageID{
+		ApplicationKey: plan.ApplicationKey.ValueString(),
+		Version:        plan.Version.ValueString(),
+		Stage:          plan.TargetStage.ValueString(),
+	}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationVersionPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state ApplicationVersionPromotionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -210,6 +221,7 @@ func (r *ApplicationVersionPromotionResource) Read(ctx context.Context, req reso
 	}
 	// Promotion is a one-shot action; we do not refresh from API. State is enough.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *ApplicationVersionPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -218,34 +230,79 @@ func (r *ApplicationVersionPromotionResource) Update(ctx context.Context, req re
 }
 
 func (r *ApplicationVersionPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 	// No API delete for promotion; just remove from state.
 }
 
 func (r *ApplicationVersionPromotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// ID format: application_key:version:target_stage
-	parts := splitPromotionID(req.ID)
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version:target_stage (e.g. my-app:1.0.0:QA)")
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_stage"), parts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-}
-
-func splitPromotionID(id string) []string {
-	var parts []string
-	start := 0
-	for i, c := range id {
-		if c == ':' {
-			parts = append(parts, id[start:i])
-			start = i + 1
+	// ID format: application_key:version:target_stage. An import by identity has no ID.
+	var id apptrust.StageID
+	if req.ID == "" {
+		var identity ApplicationVersionPromotionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = apptrust.StageID{
+			ApplicationKey: identity.ApplicationKey.ValueString(),
+			Version:        identity.Version.ValueString(),
+			Stage:          identity.TargetStage.ValueString(),
 		}
+	} else {
+		parsed, err := apptrust.ParseStageID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version:target_stage (e.g. my-app:1.0.0:QA)")
+			return
+		}
+		id = parsed
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), id.Version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_stage"), id.Stage)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+}
+
+// MoveState moves artifactory_release_bundle_v2_promotion state of the JFrog Artifactory provider.
+// The release bundle name is the application key and the environment the target stage. The other
+// promotion attributes are taken from the configuration on the next apply, without promoting again.
+func (r *ApplicationVersionPromotionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &releaseBundleV2PromotionSourceSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isArtifactoryMove(req, releaseBundleV2PromotionTypeName) {
+					return
+				}
+				var source releaseBundleV2PromotionSourceModel
+				getMoveSourceState(ctx, req, &source, &resp.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				id := apptrust.StageID{
+					ApplicationKey: source.Name.ValueString(),
+					Version:        source.Version.ValueString(),
+					Stage:          source.Environment.ValueString(),
+				}
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("version"), id.Version)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("target_stage"), id.Stage)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), id.String())...)
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, ApplicationVersionPromotionIdentityModel{
+					ApplicationKey: source.Name,
+					Version:        source.Version,
+					TargetStage:    source.Environment,
+				})...)
+			},
+		},
 	}
-	if start <= len(id) {
-		parts = append(parts, id[start:])
+}
+
+// identity returns the resource identity of m.
+func (m *ApplicationVersionPromotionResourceModel) identity() ApplicationVersionPromotionIdentityModel {
+	return ApplicationVersionPromotionIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+		Version:        m.Version,
+		TargetStage:    m.TargetStage,
 	}
-	return parts
 }
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -89,3 +92,68 @@ func TestAccApplicationVersionPromotion_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccApplicationVersionPromotion_identity checks the resource identity of a promotion and its
+// import by identity.
+func TestAccApplicationVersionPromotion_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	targetStage := os.Getenv("APPTRUST_TEST_TARGET_STAGE")
+	if targetStage == "" {
+		targetStage = "QA"
+	}
+
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	versionId, versionFqrn, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+	_, promoFqrn, promoName := testutil.MkNames("test-promo-", "apptrust_application_version_promotion")
+	appKey := fmt.Sprintf("app-%d", id)
+	version := fmt.Sprintf("1.0.%d", versionId)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "%s"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+		resource "apptrust_application_version_promotion" "%s" {
+			application_key = apptrust_application_version.%s.application_key
+			version         = apptrust_application_version.%s.version
+			target_stage    = "%s"
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, versionName, appName, version, promoName, versionName, versionName, targetStage)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationVersionDestroy(versionFqrn),
+			testAccCheckApplicationDestroy(appFqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(promoFqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+						"version":         knownvalue.StringExact(version),
+						"target_stage":    knownvalue.StringExact(targetStage),
+					}),
+				},
+			},
+			{
+				ResourceName:    promoFqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -16,22 +16,21 @@ package resource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &ApplicationVersionReleaseResource{}
+var _ resource.ResourceWithIdentity = &ApplicationVersionReleaseResource{}
 
 func NewApplicationVersionReleaseResource() resource.Resource {
 	return &ApplicationVersionReleaseResource{
@@ -40,8 +39,9 @@ func NewApplicationVersionReleaseResource() resource.Resource {
 }
 
 type ApplicationVersionReleaseResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type ApplicationVersionReleaseResourceModel struct {
@@ -54,11 +54,12 @@ type ApplicationVersionReleaseResourceModel struct {
 	PromotionAuthorizationType types.String `tfsdk:"promotion_authorization_type"`
 }
 
-type releaseAppVersionRequestBody struct {
-	PromotionType              string   `json:"promotion_type,omitempty"`
-	IncludedRepositoryKeys     []string `json:"included_repository_keys,omitempty"`
-	ExcludedRepositoryKeys     []string `json:"excluded_repository_keys,omitempty"`
-	PromotionAuthorizationType string   `json:"promotion_authorization_type,omitempty"`
+// applicationVersionReleaseFieldPaths maps the fields of release request errors to the attributes they are sent from.
+var applicationVersionReleaseFieldPaths = apptrust.FieldPaths{
+	"promotion_type":               {Path: path.Root("promotion_type")},
+	"included_repository_keys":     {Path: path.Root("included_repository_keys")},
+	"excluded_repository_keys":     {Path: path.Root("excluded_repository_keys")},
+	"promotion_authorization_type": {Path: path.Root("promotion_authorization_type")},
 }
 
 func (r *ApplicationVersionReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,15 +113,31 @@ func (r *ApplicationVersionReleaseResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *ApplicationVersionReleaseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
+			"version": identityschema.StringAttribute{
+				Description:       "The released application version.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApplicationVersionReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *ApplicationVersionReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan ApplicationVersionReleaseResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -132,7 +149,7 @@ func (r *ApplicationVersionReleaseResource) Create(ctx context.Context, req reso
 	if !plan.PromotionType.IsNull() && !plan.PromotionType.IsUnknown() {
 		promotionType = plan.PromotionType.ValueString()
 	}
-	body := releaseAppVersionRequestBody{PromotionType: promotionType}
+	body := client.ReleaseRequest{PromotionType: promotionType}
 	if !plan.PromotionAuthorizationType.IsNull() && !plan.PromotionAuthorizationType.IsUnknown() {
 		body.PromotionAuthorizationType = plan.PromotionAuthorizationType.ValueString()
 	}
@@ -149,35 +166,24 @@ func (r *ApplicationVersionReleaseResource) Create(ctx context.Context, req reso
 		}
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetPathParam("version", plan.Version.ValueString()).
-		SetBody(body).
-		Post(ApplicationVersionReleaseEP)
-
+	err := r.client.Promotions().Release(ctx, plan.ApplicationKey.ValueString(), plan.Version.ValueString(), body)
 	if err != nil {
 		tflog.Error(ctx, "Failed to release application version", map[string]interface{}{
 			"application_key": plan.ApplicationKey.ValueString(),
 			"version":         plan.Version.ValueString(),
 			"error":           err.Error(),
 		})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "release", "application version", applicationVersionReleaseFieldPaths)...)
 		return
 	}
 
-	if httpResponse.StatusCode() != http.StatusOK && httpResponse.StatusCode() != http.StatusAccepted {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "release", "application version")
-		resp.Diagnostics.Append(errorDiags...)
-		return
-	}
-
-	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.ApplicationKey.ValueString(), plan.Version.ValueString()))
+	plan.ID = types.StringValue(apptrust.VersionID{ApplicationKey: plan.ApplicationKey.ValueString(), Version: plan.Version.ValueString()}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationVersionReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state ApplicationVersionReleaseResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -185,6 +191,7 @@ func (r *ApplicationVersionReleaseResource) Read(ctx context.Context, req resour
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *ApplicationVersionReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -192,19 +199,40 @@ func (r *ApplicationVersionReleaseResource) Update(ctx context.Context, req reso
 }
 
 func (r *ApplicationVersionReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 	// No API delete for release; just remove from state.
 }
 
 func (r *ApplicationVersionReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// ID format: application_key:version
-	for i, c := range req.ID {
-		if c == ':' {
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), req.ID[:i])...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), req.ID[i+1:])...)
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	// ID format: application_key:version. An import by identity has no ID.
+	var id apptrust.VersionID
+	if req.ID == "" {
+		var identity ApplicationVersionIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = apptrust.VersionID{
+			ApplicationKey: identity.ApplicationKey.ValueString(),
+			Version:        identity.Version.ValueString(),
+		}
+	} else {
+		parsed, err := apptrust.ParseVersionID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version")
 			return
 		}
+		id = parsed
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), id.Version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+}
+
+// identity returns the resource identity of m.
+func (m *ApplicationVersionReleaseResourceModel) identity() ApplicationVersionIdentityModel {
+	return ApplicationVersionIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+		Version:        m.Version,
 	}
-	resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version")
 }
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -84,3 +87,64 @@ func TestAccApplicationVersionRelease_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccApplicationVersionRelease_identity checks the resource identity of a release and its
+// import by identity. Requires PROD stage and release policies in the project.
+func TestAccApplicationVersionRelease_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+	if os.Getenv("APPTRUST_TEST_RELEASE") == "" {
+		t.Skip("Set APPTRUST_TEST_RELEASE=1 to run application version release acceptance test (requires PROD stage)")
+	}
+
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	versionId, versionFqrn, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+	_, releaseFqrn, releaseName := testutil.MkNames("test-release-", "apptrust_application_version_release")
+	appKey := fmt.Sprintf("app-%d", id)
+	version := fmt.Sprintf("1.0.%d", versionId)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "%s"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+		resource "apptrust_application_version_release" "%s" {
+			application_key = apptrust_application_version.%s.application_key
+			version         = apptrust_application_version.%s.version
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, versionName, appName, version, releaseName, versionName, versionName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationVersionDestroy(versionFqrn),
+			testAccCheckApplicationDestroy(appFqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(releaseFqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+						"version":         knownvalue.StringExact(version),
+					}),
+				},
+			},
+			{
+				ResourceName:    releaseFqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
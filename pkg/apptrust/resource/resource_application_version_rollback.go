@@ -16,22 +16,21 @@ package resource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &ApplicationVersionRollbackResource{}
+var _ resource.ResourceWithIdentity = &ApplicationVersionRollbackResource{}
 
 func NewApplicationVersionRollbackResource() resource.Resource {
 	return &ApplicationVersionRollbackResource{
@@ -40,8 +39,9 @@ func NewApplicationVersionRollbackResource() resource.Resource {
 }
 
 type ApplicationVersionRollbackResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type ApplicationVersionRollbackResourceModel struct {
@@ -51,8 +51,16 @@ type ApplicationVersionRollbackResourceModel struct {
 	FromStage      types.String `tfsdk:"from_stage"`
 }
 
-type rollbackAppVersionRequestBody struct {
-	FromStage string `json:"from_stage"`
+// ApplicationVersionRollbackIdentityModel identifies the rollback of an application version from a stage.
+type ApplicationVersionRollbackIdentityModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	Version        types.String `tfsdk:"version"`
+	FromStage      types.String `tfsdk:"from_stage"`
+}
+
+// applicationVersionRollbackFieldPaths maps the fields of rollback request errors to the attributes they are sent from.
+var applicationVersionRollbackFieldPaths = apptrust.FieldPaths{
+	"from_stage": {Path: path.Root("from_stage")},
 }
 
 func (r *ApplicationVersionRollbackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -95,15 +103,35 @@ func (r *ApplicationVersionRollbackResource) Schema(ctx context.Context, req res
 	}
 }
 
+func (r *ApplicationVersionRollbackResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
+			"version": identityschema.StringAttribute{
+				Description:       "The rolled back application version.",
+				RequiredForImport: true,
+			},
+			"from_stage": identityschema.StringAttribute{
+				Description:       "Stage the version was rolled back from.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApplicationVersionRollbackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *ApplicationVersionRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan ApplicationVersionRollbackResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -111,15 +139,9 @@ func (r *ApplicationVersionRollbackResource) Create(ctx context.Context, req res
 		return
 	}
 
-	body := rollbackAppVersionRequestBody{FromStage: plan.FromStage.ValueString()}
-
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetPathParam("version", plan.Version.ValueString()).
-		SetBody(body).
-		Post(ApplicationVersionRollbackEP)
+	body := client.RollbackRequest{FromStage: plan.FromStage.ValueString()}
 
+	err := r.client.Promotions().Rollback(ctx, plan.ApplicationKey.ValueString(), plan.Version.ValueString(), body)
 	if err != nil {
 		tflog.Error(ctx, "Failed to roll back application version", map[string]interface{}{
 			"application_key": plan.ApplicationKey.ValueString(),
@@ -127,22 +149,21 @@ func (r *ApplicationVersionRollbackResource) Create(ctx context.Context, req res
 			"from_stage":      plan.FromStage.ValueString(),
 			"error":           err.Error(),
 		})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK && httpResponse.StatusCode() != http.StatusAccepted {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "rollback", "application version")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "rollback", "application version", applicationVersionRollbackFieldPaths)...)
 		return
 	}
 
-	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", plan.ApplicationKey.ValueString(), plan.Version.ValueString(), plan.FromStage.ValueString()))
+	plan.ID = types.StringValue(apptrust.StageID{
+		ApplicationKey: plan.ApplicationKey.ValueString(),
+		Version:        plan.Version.ValueString(),
+		Stage:          plan.FromStage.ValueString(),
+	}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *ApplicationVersionRollbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state ApplicationVersionRollbackResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -150,6 +171,7 @@ func (r *ApplicationVersionRollbackResource) Read(ctx context.Context, req resou
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *ApplicationVersionRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -157,18 +179,43 @@ func (r *ApplicationVersionRollbackResource) Update(ctx context.Context, req res
 }
 
 func (r *ApplicationVersionRollbackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 	// No API delete for rollback; just remove from state.
 }
 
 func (r *ApplicationVersionRollbackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitPromotionID(req.ID)
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version:from_stage")
-		return
+	// ID format: application_key:version:from_stage. An import by identity has no ID.
+	var id apptrust.StageID
+	if req.ID == "" {
+		var identity ApplicationVersionRollbackIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id = apptrust.StageID{
+			ApplicationKey: identity.ApplicationKey.ValueString(),
+			Version:        identity.Version.ValueString(),
+			Stage:          identity.FromStage.ValueString(),
+		}
+	} else {
+		parsed, err := apptrust.ParseStageID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Import ID must be application_key:version:from_stage")
+			return
+		}
+		id = parsed
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), id.Version)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_stage"), id.Stage)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+}
+
+// identity returns the resource identity of m.
+func (m *ApplicationVersionRollbackResourceModel) identity() ApplicationVersionRollbackIdentityModel {
+	return ApplicationVersionRollbackIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+		Version:        m.Version,
+		FromStage:      m.FromStage,
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_stage"), parts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -94,3 +97,74 @@ func TestAccApplicationVersionRollback_basic(t *testing.T) {
 		},
 	})
 }
+
+// TestAccApplicationVersionRollback_identity checks the resource identity of a rollback and its
+// import by identity.
+func TestAccApplicationVersionRollback_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	targetStage := os.Getenv("APPTRUST_TEST_TARGET_STAGE")
+	if targetStage == "" {
+		targetStage = "QA"
+	}
+
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	versionId, versionFqrn, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+	_, _, promoName := testutil.MkNames("test-promo-", "apptrust_application_version_promotion")
+	_, rollbackFqrn, rollbackName := testutil.MkNames("test-rollback-", "apptrust_application_version_rollback")
+	appKey := fmt.Sprintf("app-%d", id)
+	version := fmt.Sprintf("1.0.%d", versionId)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "%s"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+		resource "apptrust_application_version_promotion" "%s" {
+			application_key = apptrust_application_version.%s.application_key
+			version         = apptrust_application_version.%s.version
+			target_stage    = "%s"
+		}
+		resource "apptrust_application_version_rollback" "%s" {
+			application_key = apptrust_application_version_promotion.%s.application_key
+			version         = apptrust_application_version_promotion.%s.version
+			from_stage      = "%s"
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, versionName, appName, version, promoName, versionName, versionName, targetStage, rollbackName, promoName, promoName, targetStage)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationVersionDestroy(versionFqrn),
+			testAccCheckApplicationDestroy(appFqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rollbackFqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+						"version":         knownvalue.StringExact(version),
+						"from_stage":      knownvalue.StringExact(targetStage),
+					}),
+				},
+			},
+			{
+				ResourceName:    rollbackFqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -20,7 +20,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -178,3 +181,57 @@ func testAccCheckApplicationVersionDestroy(fqrn string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// TestAccApplicationVersion_identity checks the resource identity of an application version and its import by identity.
+func TestAccApplicationVersion_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	versionId, versionFqrn, versionName := testutil.MkNames("test-ver-", "apptrust_application_version")
+	appKey := fmt.Sprintf("app-%d", id)
+	version := fmt.Sprintf("1.0.%d", versionId)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_application_version" "%s" {
+			application_key  = apptrust_application.%s.application_key
+			version          = "%s"
+			source_artifacts = [{ path = "generic-repo/readme.md" }]
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, versionName, appName, version)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckApplicationVersionDestroy(versionFqrn),
+			testAccCheckApplicationDestroy(appFqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(versionFqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+						"version":         knownvalue.StringExact(version),
+					}),
+				},
+			},
+			{
+				ResourceName:    versionFqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+				// List API does not return sources, so the import plans an update of them.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
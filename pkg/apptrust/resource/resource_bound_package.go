@@ -16,29 +16,21 @@ package resource
 
 import (
 	"context"
-	"fmt"
-	"net/http"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
-	"github.com/jfrog/terraform-provider-shared/util"
-	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
-)
-
-const (
-	ApplicationPackagesEndpoint        = ApplicationEndpoint + "/packages"
-	ApplicationPackageVersionsEndpoint = ApplicationPackagesEndpoint + "/{type}/{name}"
-	ApplicationPackageVersionEndpoint  = ApplicationPackagesEndpoint + "/{type}/{name}/{version}"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/client"
 )
 
 var _ resource.Resource = &BoundPackageResource{}
+var _ resource.ResourceWithIdentity = &BoundPackageResource{}
 
 func NewBoundPackageResource() resource.Resource {
 	return &BoundPackageResource{
@@ -47,8 +39,9 @@ func NewBoundPackageResource() resource.Resource {
 }
 
 type BoundPackageResource struct {
-	ProviderData util.ProviderMetadata
+	ProviderData apptrust.ProviderMetadata
 	TypeName     string
+	client       client.Client
 }
 
 type BoundPackageResourceModel struct {
@@ -59,10 +52,19 @@ type BoundPackageResourceModel struct {
 	PackageVersion types.String `tfsdk:"package_version"`
 }
 
-type bindPackageRequestBody struct {
-	PackageType    string `json:"package_type"`
-	PackageName    string `json:"package_name"`
-	PackageVersion string `json:"package_version"`
+// BoundPackageIdentityModel identifies a package version bound to an application.
+type BoundPackageIdentityModel struct {
+	ApplicationKey types.String `tfsdk:"application_key"`
+	PackageType    types.String `tfsdk:"package_type"`
+	PackageName    types.String `tfsdk:"package_name"`
+	PackageVersion types.String `tfsdk:"package_version"`
+}
+
+// boundPackageFieldPaths maps the fields of bound package request errors to the attributes they are sent from.
+var boundPackageFieldPaths = apptrust.FieldPaths{
+	"package_type":    {Path: path.Root("package_type")},
+	"package_name":    {Path: path.Root("package_name")},
+	"package_version": {Path: path.Root("package_version")},
 }
 
 func (r *BoundPackageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,19 +115,39 @@ func (r *BoundPackageResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *BoundPackageResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_key": identityschema.StringAttribute{
+				Description:       "The application key.",
+				RequiredForImport: true,
+			},
+			"package_type": identityschema.StringAttribute{
+				Description:       "Package type (e.g. maven, docker, npm).",
+				RequiredForImport: true,
+			},
+			"package_name": identityschema.StringAttribute{
+				Description:       "Package name.",
+				RequiredForImport: true,
+			},
+			"package_version": identityschema.StringAttribute{
+				Description:       "Package version.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *BoundPackageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
-	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
-}
-
-func boundPackageID(appKey, pkgType, name, version string) string {
-	return fmt.Sprintf("%s:%s:%s:%s", appKey, pkgType, name, version)
+	r.ProviderData = req.ProviderData.(apptrust.ProviderMetadata)
+	r.client = client.New(r.ProviderData.Client)
 }
 
 func (r *BoundPackageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "CREATE")
 
 	var plan BoundPackageResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -133,41 +155,31 @@ func (r *BoundPackageResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	body := bindPackageRequestBody{
+	body := client.BindPackageRequest{
 		PackageType:    plan.PackageType.ValueString(),
 		PackageName:    plan.PackageName.ValueString(),
 		PackageVersion: plan.PackageVersion.ValueString(),
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", plan.ApplicationKey.ValueString()).
-		SetBody(body).
-		Post(ApplicationPackagesEndpoint)
-
+	err := r.client.Packages().Bind(ctx, plan.ApplicationKey.ValueString(), body)
 	if err != nil {
 		tflog.Error(ctx, "Failed to bind package", map[string]interface{}{"error": err.Error()})
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusCreated {
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "create", "bound package")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientErrorWithPaths(err, "create", "bound package", boundPackageFieldPaths)...)
 		return
 	}
 
-	plan.ID = types.StringValue(boundPackageID(
-		plan.ApplicationKey.ValueString(),
-		plan.PackageType.ValueString(),
-		plan.PackageName.ValueString(),
-		plan.PackageVersion.ValueString(),
-	))
+	plan.ID = types.StringValue(apptrust.BoundPackageID{
+		ApplicationKey: plan.ApplicationKey.ValueString(),
+		PackageType:    plan.PackageType.ValueString(),
+		PackageName:    plan.PackageName.ValueString(),
+		PackageVersion: plan.PackageVersion.ValueString(),
+	}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *BoundPackageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "READ")
 
 	var state BoundPackageResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -181,13 +193,11 @@ func (r *BoundPackageResource) Read(ctx context.Context, req resource.ReadReques
 	version := state.PackageVersion.ValueString()
 	if appKey == "" || pkgType == "" || name == "" || version == "" {
 		// Parse from id: application_key:type:name:version (name may contain colons e.g. maven group:artifact)
-		id := state.ID.ValueString()
-		parts := splitBoundPackageID(id)
-		if len(parts) >= 4 {
-			appKey = parts[0]
-			pkgType = parts[1]
-			name = parts[2]
-			version = parts[3]
+		if id, err := apptrust.ParseBoundPackageID(state.ID.ValueString()); err == nil {
+			appKey = id.ApplicationKey
+			pkgType = id.PackageType
+			name = id.PackageName
+			version = id.PackageVersion
 		}
 	}
 	if appKey == "" || pkgType == "" || name == "" || version == "" {
@@ -196,32 +206,15 @@ func (r *BoundPackageResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Verify binding exists by checking if this version is in the bound package versions list
-	var listResp struct {
-		Versions []struct {
-			Version string `json:"version"`
-		} `json:"versions"`
-	}
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", appKey).
-		SetPathParam("type", pkgType).
-		SetPathParam("name", name).
-		SetQueryParam("package_version", version).
-		SetResult(&listResp).
-		Get(ApplicationPackageVersionsEndpoint)
-
+	listResp, err := r.client.Packages().ListVersions(ctx, appKey, pkgType, name, client.PackageVersionListOptions{
+		PackageVersion: version,
+	})
 	if err != nil {
-		utilfw.UnableToRefreshResourceError(resp, err.Error())
-		return
-	}
-
-	if httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "read", "bound package")
-		resp.Diagnostics.Append(errorDiags...)
+		resp.Diagnostics.Append(apptrust.HandleClientError(err, "read", "bound package")...)
 		return
 	}
 
@@ -244,30 +237,9 @@ func (r *BoundPackageResource) Read(ctx context.Context, req resource.ReadReques
 	state.PackageType = types.StringValue(pkgType)
 	state.PackageName = types.StringValue(name)
 	state.PackageVersion = types.StringValue(version)
-	state.ID = types.StringValue(boundPackageID(appKey, pkgType, name, version))
+	state.ID = types.StringValue(apptrust.BoundPackageID{ApplicationKey: appKey, PackageType: pkgType, PackageName: name, PackageVersion: version}.String())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-}
-
-// splitBoundPackageID splits id "appKey:type:name:version" where name may contain colons (e.g. maven group:artifact).
-// We split from the end: last part is version, second-to-last is the last segment of name, etc.
-// Simplest: split by ":" and take first as appKey, second as type, last as version, and join the rest as name.
-func splitBoundPackageID(id string) []string {
-	var parts []string
-	var cur string
-	for _, c := range id {
-		if c == ':' {
-			if cur != "" {
-				parts = append(parts, cur)
-				cur = ""
-			}
-		} else {
-			cur += string(c)
-		}
-	}
-	if cur != "" {
-		parts = append(parts, cur)
-	}
-	return parts
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
 }
 
 func (r *BoundPackageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -278,10 +250,11 @@ func (r *BoundPackageResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 	// No updatable attributes; all require replace.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
 }
 
 func (r *BoundPackageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+	r.ProviderData.Usage.RecordResource(r.TypeName, "DELETE")
 
 	var state BoundPackageResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -294,47 +267,58 @@ func (r *BoundPackageResource) Delete(ctx context.Context, req resource.DeleteRe
 	name := state.PackageName.ValueString()
 	version := state.PackageVersion.ValueString()
 	if appKey == "" || pkgType == "" || name == "" || version == "" {
-		parts := splitBoundPackageID(state.ID.ValueString())
-		if len(parts) >= 4 {
-			appKey = parts[0]
-			pkgType = parts[1]
-			name = parts[2]
-			version = parts[3]
+		if id, err := apptrust.ParseBoundPackageID(state.ID.ValueString()); err == nil {
+			appKey = id.ApplicationKey
+			pkgType = id.PackageType
+			name = id.PackageName
+			version = id.PackageVersion
 		}
 	}
 
-	httpResponse, err := r.ProviderData.Client.R().
-		SetContext(ctx).
-		SetPathParam("application_key", appKey).
-		SetPathParam("type", pkgType).
-		SetPathParam("name", name).
-		SetPathParam("version", version).
-		Delete(ApplicationPackageVersionEndpoint)
-
-	if err != nil {
-		utilfw.UnableToDeleteResourceError(resp, err.Error())
+	err := r.client.Packages().Unbind(ctx, appKey, pkgType, name, version)
+	if err == nil || client.IsNotFound(err) {
 		return
 	}
+	resp.Diagnostics.Append(apptrust.HandleClientError(err, "delete", "bound package")...)
+}
 
-	if httpResponse.StatusCode() != http.StatusNoContent && httpResponse.StatusCode() != http.StatusOK {
-		if httpResponse.StatusCode() == http.StatusNotFound {
+func (r *BoundPackageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// ID format: application_key:package_type:package_name:package_version. An import by identity
+	// has no ID.
+	var id apptrust.BoundPackageID
+	if req.ID == "" {
+		var identity BoundPackageIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		errorDiags := apptrust.HandleAPIErrorWithType(httpResponse, "delete", "bound package")
-		resp.Diagnostics.Append(errorDiags...)
+		id = apptrust.BoundPackageID{
+			ApplicationKey: identity.ApplicationKey.ValueString(),
+			PackageType:    identity.PackageType.ValueString(),
+			PackageName:    identity.PackageName.ValueString(),
+			PackageVersion: identity.PackageVersion.ValueString(),
+		}
+	} else {
+		parsed, err := apptrust.ParseBoundPackageID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", "Use application_key:package_type:package_name:package_version (e.g. my-app:maven:com.example:lib:1.0.0)")
+			return
+		}
+		id = parsed
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), id.ApplicationKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_type"), id.PackageType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), id.PackageName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_version"), id.PackageVersion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 }
 
-func (r *BoundPackageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitBoundPackageID(req.ID)
-	if len(parts) < 4 {
-		resp.Diagnostics.AddError("Invalid import ID", "Use application_key:package_type:package_name:package_version (e.g. my-app:maven:com.example:lib:1.0.0)")
-		return
+// identity returns the resource identity of m.
+func (m *BoundPackageResourceModel) identity() BoundPackageIdentityModel {
+	return BoundPackageIdentityModel{
+		ApplicationKey: m.ApplicationKey,
+		PackageType:    m.PackageType,
+		PackageName:    m.PackageName,
+		PackageVersion: m.PackageVersion,
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_key"), parts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_type"), parts[1])...)
-	// package_name may contain colons (e.g. maven group:artifact)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_name"), strings.Join(parts[2:len(parts)-1], ":"))...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("package_version"), parts[len(parts)-1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
@@ -21,7 +21,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust/acctest"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 )
@@ -134,3 +137,64 @@ func testAccCheckBoundPackageDestroy(fqrn string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// TestAccBoundPackage_identity checks the resource identity of a bound package and its import by identity.
+func TestAccBoundPackage_identity(t *testing.T) {
+	acctest.SkipIfNotAcc(t)
+	acctest.PreCheck(t)
+
+	pkgType := os.Getenv("APPTRUST_TEST_PACKAGE_TYPE")
+	pkgName := os.Getenv("APPTRUST_TEST_PACKAGE_NAME")
+	pkgVersion := os.Getenv("APPTRUST_TEST_PACKAGE_VERSION")
+	if pkgType == "" || pkgName == "" || pkgVersion == "" {
+		t.Skip("Set APPTRUST_TEST_PACKAGE_TYPE, APPTRUST_TEST_PACKAGE_NAME, APPTRUST_TEST_PACKAGE_VERSION to run bound package acceptance test")
+	}
+
+	id, appFqrn, appName := testutil.MkNames("test-app-", "apptrust_application")
+	_, pkgFqrn, pkgNameRes := testutil.MkNames("test-pkg-", "apptrust_bound_package")
+	appKey := fmt.Sprintf("app-%d", id)
+
+	config := fmt.Sprintf(`
+		resource "apptrust_application" "%s" {
+			application_key  = "%s"
+			application_name = "%s"
+			project_key      = "%s"
+		}
+		resource "apptrust_bound_package" "%s" {
+			application_key = apptrust_application.%s.application_key
+			package_type    = "%s"
+			package_name    = "%s"
+			package_version = "%s"
+		}
+	`, appName, appKey, appName, acctest.AppTrustProjectKey1, pkgNameRes, appName, pkgType, pkgName, pkgVersion)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckBoundPackageDestroy(pkgFqrn),
+			testAccCheckApplicationDestroy(appFqrn),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(pkgFqrn, map[string]knownvalue.Check{
+						"application_key": knownvalue.StringExact(appKey),
+						"package_type":    knownvalue.StringExact(pkgType),
+						"package_name":    knownvalue.StringExact(pkgName),
+						"package_version": knownvalue.StringExact(pkgVersion),
+					}),
+				},
+			},
+			{
+				ResourceName:    pkgFqrn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+)
+
+// CompareVersions compares two semantic versions and returns -1, 0 or 1 when a is lower than,
+// equal to or greater than b. Pre-releases sort before the release they precede.
+func CompareVersions(a, b string) (int, error) {
+	va, err := version.NewSemver(a)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", a, err)
+	}
+	vb, err := version.NewSemver(b)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", b, err)
+	}
+	return va.Compare(vb), nil
+}
+
+// NextVersion returns the version following current when incrementing part ("major", "minor" or
+// "patch"). Lower parts are reset to 0 and any pre-release or build metadata is dropped, except that
+// the next version of a pre-release is the release it precedes when that release already
+// increments part: 1.2.3-rc.1 -> 1.2.3 for patch, 1.5.0-rc.1 -> 1.5.0 for minor and
+// 2.0.0-rc.1 -> 2.0.0 for major. A leading "v" is kept.
+func NextVersion(current, part string) (string, error) {
+	v, err := version.NewSemver(current)
+	if err != nil {
+		return "", fmt.Errorf("invalid version %q: %w", current, err)
+	}
+
+	segments := v.Segments()
+	major, minor, patch := segments[0], segments[1], segments[2]
+	preRelease := v.Prerelease() != ""
+	switch part {
+	case "major":
+		if !preRelease || minor != 0 || patch != 0 {
+			major++
+		}
+		minor, patch = 0, 0
+	case "minor":
+		if !preRelease || patch != 0 {
+			minor++
+		}
+		patch = 0
+	case "patch":
+		if !preRelease {
+			patch++
+		}
+	default:
+		return "", fmt.Errorf("invalid part %q: must be one of major, minor or patch", part)
+	}
+
+	prefix := ""
+	if strings.HasPrefix(current, "v") {
+		prefix = "v"
+	}
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"testing"
+
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0.0", "1.9.9", 1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"v1.0.0", "1.0.0", 0},
+	}
+	for _, tt := range tests {
+		got, err := apptrust.CompareVersions(tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, %v, want %d", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+	if _, err := apptrust.CompareVersions("latest", "1.0.0"); err == nil {
+		t.Error("expected an error comparing an invalid version")
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	tests := []struct {
+		current, part, want string
+	}{
+		{"1.2.3", "major", "2.0.0"},
+		{"1.2.3", "minor", "1.3.0"},
+		{"1.2.3", "patch", "1.2.4"},
+		{"1.2.3-rc.1", "patch", "1.2.3"},
+		{"1.2.3-rc.1+build.5", "minor", "1.3.0"},
+		{"1.5.0-rc.1", "minor", "1.5.0"},
+		{"1.5.0-rc.1", "major", "2.0.0"},
+		{"2.0.0-rc.1", "major", "2.0.0"},
+		{"2.0.0-rc.1", "minor", "2.0.0"},
+		{"2.0.0-rc.1", "patch", "2.0.0"},
+		{"v0.9.0", "minor", "v0.10.0"},
+	}
+	for _, tt := range tests {
+		got, err := apptrust.NextVersion(tt.current, tt.part)
+		if err != nil || got != tt.want {
+			t.Errorf("NextVersion(%q, %q) = %q, %v, want %q", tt.current, tt.part, got, err, tt.want)
+		}
+	}
+	if _, err := apptrust.NextVersion("1.2.3", "build"); err == nil {
+		t.Error("expected an error for an invalid part")
+	}
+}
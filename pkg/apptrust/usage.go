@@ -0,0 +1,113 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// usageEndpoint and usagePartnerFeatureId match the shared util.SendUsage helper.
+const (
+	usageEndpoint         = "artifactory/api/system/usage"
+	usagePartnerFeatureId = "Partner/ACC-007450"
+)
+
+// usageFeature is a feature of the usage report, in the format of util.SendUsage. The format has
+// no count, so a feature used several times is listed once per use.
+type usageFeature struct {
+	FeatureId string `json:"featureId"`
+}
+
+// usageReport is the body of the usage endpoint.
+type usageReport struct {
+	ProductId string         `json:"productId"`
+	Features  []usageFeature `json:"features"`
+}
+
+// UsageReporter counts usage telemetry features during a provider run and sends them to the
+// platform as a single report when flushed, instead of one request per operation. A nil
+// *UsageReporter records nothing, which is how disabled telemetry is represented.
+type UsageReporter struct {
+	restyClient *resty.Client
+	productId   string
+
+	mu sync.Mutex
+	// features holds one entry per use, in the order of use.
+	features []string
+}
+
+// NewUsageReporter returns a reporter sending its report with restyClient.
+func NewUsageReporter(restyClient *resty.Client, productId string) *UsageReporter {
+	return &UsageReporter{
+		restyClient: restyClient,
+		productId:   productId,
+	}
+}
+
+// Record counts one use of feature.
+func (u *UsageReporter) Record(feature string) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.features = append(u.features, feature)
+}
+
+// RecordResource counts one CREATE, READ, UPDATE or DELETE of a resource type, using the feature
+// names of the shared util.SendUsageResource* helpers.
+func (u *UsageReporter) RecordResource(resourceName, operation string) {
+	u.Record(fmt.Sprintf("Resource/%s/%s", resourceName, operation))
+}
+
+// Flush sends the features recorded since the last flush in one request, with an entry per use.
+// Failures are only logged, as for the per-operation usage requests.
+func (u *UsageReporter) Flush(ctx context.Context) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	features := u.features
+	u.features = nil
+	u.mu.Unlock()
+
+	if len(features) == 0 {
+		return
+	}
+	report := usageReport{
+		ProductId: u.productId,
+		Features:  []usageFeature{{FeatureId: usagePartnerFeatureId}},
+	}
+	for _, feature := range features {
+		report.Features = append(report.Features, usageFeature{FeatureId: feature})
+	}
+
+	tflog.Debug(ctx, "Sending usage report", map[string]interface{}{
+		"features": len(features),
+	})
+	resp, err := u.restyClient.R().SetContext(ctx).SetBody(report).Post(usageEndpoint)
+	if err != nil {
+		tflog.Info(ctx, fmt.Sprintf("failed to send usage: %v", err))
+		return
+	}
+	if resp.IsError() {
+		tflog.Info(ctx, fmt.Sprintf("failed to send usage: %v", resp.String()))
+	}
+}
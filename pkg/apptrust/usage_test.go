@@ -0,0 +1,70 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apptrust_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jfrog/terraform-provider-apptrust/pkg/apptrust"
+)
+
+func TestUsageReporter_Flush(t *testing.T) {
+	var reports []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/artifactory/api/system/usage" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var report map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			t.Errorf("failed to decode the report: %v", err)
+		}
+		reports = append(reports, report)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	reporter := apptrust.NewUsageReporter(resty.New().SetBaseURL(server.URL), "terraform-provider-apptrust/1.0.0")
+	reporter.RecordResource("apptrust_application", "READ")
+	reporter.RecordResource("apptrust_application", "READ")
+	reporter.RecordResource("apptrust_application_version", "CREATE")
+	reporter.Flush(ctx)
+	// Nothing was recorded since the last flush.
+	reporter.Flush(ctx)
+
+	if len(reports) != 1 {
+		t.Fatalf("expected a single report, got %d", len(reports))
+	}
+	got, err := json.Marshal(reports[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The usage format has no count, so repeated uses are repeated entries.
+	want := `{"features":[{"featureId":"Partner/ACC-007450"},{"featureId":"Resource/apptrust_application/READ"},` +
+		`{"featureId":"Resource/apptrust_application/READ"},{"featureId":"Resource/apptrust_application_version/CREATE"}],` +
+		`"productId":"terraform-provider-apptrust/1.0.0"}`
+	if string(got) != want {
+		t.Errorf("unexpected report\n got: %s\nwant: %s", got, want)
+	}
+
+	// A nil reporter, for disabled telemetry, records and sends nothing.
+	var disabled *apptrust.UsageReporter
+	disabled.RecordResource("apptrust_application", "READ")
+	disabled.Flush(ctx)
+}